@@ -1,6 +1,9 @@
 package v1
 
 import (
+	"fmt"
+	"time"
+
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -18,3 +21,119 @@ func Updating() xpv1.Condition {
 		LastTransitionTime: metav1.Now(),
 	}
 }
+
+// TypeUpgrading indicates whether a major version upgrade of the service is in progress.
+const TypeUpgrading xpv1.ConditionType = "Upgrading"
+
+// Reasons of the Upgrading condition.
+const (
+	ReasonUpgradeCheckPending xpv1.ConditionReason = "UpgradeCheckPending"
+	ReasonUpgradeCheckFailed  xpv1.ConditionReason = "UpgradeCheckFailed"
+	ReasonUpgradeInProgress   xpv1.ConditionReason = "UpgradeInProgress"
+	ReasonUpgradeComplete     xpv1.ConditionReason = "UpgradeComplete"
+)
+
+// UpgradeCheckPending returns an Upgrading condition where the pre-flight check of an upgrade is running.
+func UpgradeCheckPending(target string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgrading,
+		Status:             corev1.ConditionFalse,
+		Reason:             ReasonUpgradeCheckPending,
+		Message:            fmt.Sprintf("Checking whether the service can be upgraded to version %s", target),
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// UpgradeCheckFailed returns an Upgrading condition where the pre-flight check of an upgrade failed.
+func UpgradeCheckFailed(target, result string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgrading,
+		Status:             corev1.ConditionFalse,
+		Reason:             ReasonUpgradeCheckFailed,
+		Message:            fmt.Sprintf("The service cannot be upgraded to version %s: %s", target, result),
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// UpgradeInProgress returns an Upgrading condition where the service is being upgraded.
+func UpgradeInProgress(target string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgrading,
+		Status:             corev1.ConditionTrue,
+		Reason:             ReasonUpgradeInProgress,
+		Message:            fmt.Sprintf("The service is being upgraded to version %s", target),
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// UpgradeComplete returns an Upgrading condition where the upgrade of the service has finished.
+func UpgradeComplete(target string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgrading,
+		Status:             corev1.ConditionFalse,
+		Reason:             ReasonUpgradeComplete,
+		Message:            fmt.Sprintf("The service has been upgraded to version %s", target),
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// TypeBackupUpToDate indicates whether the most recent backup of the service is recent enough.
+const TypeBackupUpToDate xpv1.ConditionType = "BackupUpToDate"
+
+// Reasons of the BackupUpToDate condition.
+const (
+	ReasonBackupRecent   xpv1.ConditionReason = "BackupRecent"
+	ReasonBackupOutdated xpv1.ConditionReason = "BackupOutdated"
+)
+
+// BackupRecent returns a BackupUpToDate condition where the most recent backup is recent enough.
+func BackupRecent(maxAge time.Duration) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeBackupUpToDate,
+		Status:             corev1.ConditionTrue,
+		Reason:             ReasonBackupRecent,
+		Message:            fmt.Sprintf("The most recent backup is younger than %s", maxAge),
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// BackupOutdated returns a BackupUpToDate condition where no backup has been taken recently enough.
+func BackupOutdated(maxAge time.Duration) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeBackupUpToDate,
+		Status:             corev1.ConditionFalse,
+		Reason:             ReasonBackupOutdated,
+		Message:            fmt.Sprintf("No backup has been taken within the last %s", maxAge),
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// TypeExternalEndpointsResolved indicates whether the DBaaSExternalEndpoints the service should be attached to exist.
+const TypeExternalEndpointsResolved xpv1.ConditionType = "ExternalEndpointsResolved"
+
+// Reasons of the ExternalEndpointsResolved condition.
+const (
+	ReasonEndpointsResolved   xpv1.ConditionReason = "EndpointsResolved"
+	ReasonEndpointsUnresolved xpv1.ConditionReason = "EndpointsUnresolved"
+)
+
+// EndpointsResolved returns an ExternalEndpointsResolved condition where all referenced endpoints exist.
+func EndpointsResolved() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeExternalEndpointsResolved,
+		Status:             corev1.ConditionTrue,
+		Reason:             ReasonEndpointsResolved,
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// EndpointsUnresolved returns an ExternalEndpointsResolved condition where a referenced endpoint can't be resolved.
+func EndpointsUnresolved(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeExternalEndpointsResolved,
+		Status:             corev1.ConditionFalse,
+		Reason:             ReasonEndpointsUnresolved,
+		Message:            err.Error(),
+		LastTransitionTime: metav1.Now(),
+	}
+}
@@ -23,6 +23,17 @@ type DBaaSParameters struct {
 	IPFilter IPFilter `json:"ipFilter,omitempty"`
 }
 
+// SettingsPolicy determines which settings of a service are compared with the observed settings.
+type SettingsPolicy string
+
+const (
+	// SettingsPolicyFull compares all settings. Unset settings are expected to have their default value.
+	SettingsPolicyFull SettingsPolicy = "Full"
+	// SettingsPolicyManagedKeysOnly only compares the settings that are set in the spec.
+	// Other observed settings, e.g. settings added by Exoscale, are shown in the status but not treated as drift.
+	SettingsPolicyManagedKeysOnly SettingsPolicy = "ManagedKeysOnly"
+)
+
 // NodeState describes the state of a service node.
 type NodeState struct {
 	// Name of the service node
@@ -52,6 +63,16 @@ type BackupSpec struct {
 	TimeOfDay TimeOfDay `json:"timeOfDay,omitempty"`
 }
 
+// Backup describes a backup of an instance.
+type Backup struct {
+	// Name of the backup.
+	Name string `json:"name,omitempty"`
+	// Time at which the backup has been taken.
+	Time metav1.Time `json:"time,omitempty"`
+	// Size of the backup before compression, in bytes.
+	Size int64 `json:"size,omitempty"`
+}
+
 func (in *BackupSpec) Equals(other BackupSpec) bool {
 	return in.TimeOfDay.String() == other.TimeOfDay.String()
 }
@@ -116,7 +137,7 @@ func (s SizeSpec) Equals(other SizeSpec) bool {
 	return strings.EqualFold(s.Plan, other.Plan)
 }
 
-// IPFilter is a list of allowed IPv4 CIDR ranges that can access the service.
+// IPFilter is a list of allowed IP addresses or CIDR ranges that can access the service.
 // If no IP Filter is set, you may not be able to reach the service.
 // A value of `0.0.0.0/0` will open the service to all addresses on the public internet.
 type IPFilter []string
@@ -124,6 +145,110 @@ type IPFilter []string
 // Zone is the datacenter identifier in which the instance runs in.
 type Zone string
 
+// ExternalEndpointAttachment attaches a service to a DBaaSExternalEndpoint.
+type ExternalEndpointAttachment struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+
+	// Name is the name of the DBaaSExternalEndpoint.
+	Name string `json:"name"`
+}
+
+// ExternalEndpointObservation describes an external endpoint a service is attached to.
+type ExternalEndpointObservation struct {
+	// IntegrationID is the identifier of the attachment.
+	IntegrationID string `json:"integrationID,omitempty"`
+	// EndpointID is the identifier of the external endpoint.
+	EndpointID string `json:"endpointID,omitempty"`
+	// EndpointName is the name of the external endpoint.
+	EndpointName string `json:"endpointName,omitempty"`
+	// Type is the type of the external endpoint.
+	Type string `json:"type,omitempty"`
+	// Status is the status of the attachment as reported by the provider.
+	Status string `json:"status,omitempty"`
+}
+
+const (
+	// MigrationUsernameKey is the key of the username in the credentials secret of a migration.
+	MigrationUsernameKey = "username"
+	// MigrationPasswordKey is the key of the password in the credentials secret of a migration.
+	MigrationPasswordKey = "password"
+)
+
+// MigrationSpec contains settings to migrate data from an existing database server.
+type MigrationSpec struct {
+	// +kubebuilder:validation:Required
+
+	// Host is the hostname or IP address of the server to migrate data from.
+	Host string `json:"host"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+
+	// Port is the port of the server to migrate data from.
+	Port int64 `json:"port"`
+
+	// DBName is the database name for bootstrapping the initial connection.
+	DBName string `json:"dbName,omitempty"`
+
+	// +kubebuilder:validation:Enum=dump;replication
+
+	// Method of the migration.
+	Method exoscalesdk.EnumMigrationMethod `json:"method,omitempty"`
+
+	// IgnoreDBs are the databases which are not migrated.
+	IgnoreDBs []string `json:"ignoreDBs,omitempty"`
+
+	// SSL enables SSL when connecting to the server to migrate data from.
+	SSL *bool `json:"ssl,omitempty"`
+
+	// CredentialsSecretRef references a Secret containing the "username" and "password" to authenticate with the server.
+	CredentialsSecretRef *xpv1.SecretReference `json:"credentialsSecretRef,omitempty"`
+}
+
+// MigrationObservation is the observed state of a migration.
+type MigrationObservation struct {
+	// Applied is the migration configuration last sent to the provider.
+	Applied *MigrationSpec `json:"applied,omitempty"`
+	// CredentialsVersion is the resource version of the credentials secret last sent to the provider.
+	CredentialsVersion string `json:"credentialsVersion,omitempty"`
+	// Phase is the status of the migration.
+	Phase string `json:"phase,omitempty"`
+	// Method is the method of the migration.
+	Method string `json:"method,omitempty"`
+	// Error contains the error message if the migration failed.
+	Error string `json:"error,omitempty"`
+	// Details contains the status of the migration per database.
+	Details []MigrationDetail `json:"details,omitempty"`
+}
+
+// MigrationDetail is the status of the migration of a single database.
+type MigrationDetail struct {
+	// DBName is the name of the migrated database.
+	DBName string `json:"dbName,omitempty"`
+	// Method is the method of the migration.
+	Method string `json:"method,omitempty"`
+	// Status of the migration.
+	Status exoscalesdk.EnumMigrationStatus `json:"status,omitempty"`
+	// Error contains the error message if the migration failed.
+	Error string `json:"error,omitempty"`
+}
+
+// TriggerObservation is the outcome of an operation triggered by annotation.
+type TriggerObservation struct {
+	// Annotation that triggered the operation.
+	Annotation string `json:"annotation"`
+	// Value of the annotation the operation has been triggered for.
+	Value string `json:"value,omitempty"`
+	// Time at which the operation has been triggered.
+	Time metav1.Time `json:"time,omitempty"`
+	// Succeeded is true if the operation has been triggered successfully.
+	Succeeded bool `json:"succeeded,omitempty"`
+	// Message contains the outcome of the operation.
+	Message string `json:"message,omitempty"`
+}
+
 func (z Zone) String() string {
 	return string(z)
 }
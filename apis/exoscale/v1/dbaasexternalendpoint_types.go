@@ -0,0 +1,252 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Keys of the credentials secret of a DBaaSExternalEndpoint.
+const (
+	// ExternalEndpointUsernameKey is the basic auth username of a prometheus endpoint.
+	ExternalEndpointUsernameKey = "username"
+	// ExternalEndpointPasswordKey is the basic auth password of a prometheus endpoint.
+	ExternalEndpointPasswordKey = "password"
+	// ExternalEndpointAPIKeyKey is the API key of a datadog endpoint.
+	ExternalEndpointAPIKeyKey = "apiKey"
+	// ExternalEndpointURLKey is the connection URL of an elasticsearch or opensearch endpoint, including credentials.
+	ExternalEndpointURLKey = "url"
+	// ExternalEndpointCAKey is the PEM encoded CA certificate of an elasticsearch, opensearch or rsyslog endpoint.
+	ExternalEndpointCAKey = "ca.crt"
+	// ExternalEndpointCertKey is the PEM encoded client certificate of a rsyslog endpoint.
+	ExternalEndpointCertKey = "tls.crt"
+	// ExternalEndpointKeyKey is the PEM encoded client key of a rsyslog endpoint.
+	ExternalEndpointKeyKey = "tls.key"
+)
+
+// DatadogTag is a custom tag sent along with the metrics.
+type DatadogTag struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=200
+
+	// Tag is the value of the tag.
+	Tag string `json:"tag"`
+
+	// Comment is an optional explanation of the tag.
+	Comment string `json:"comment,omitempty"`
+}
+
+// DatadogEndpointSettings are the settings of a datadog endpoint.
+type DatadogEndpointSettings struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=datadoghq.com;datadoghq.eu;us3.datadoghq.com;us5.datadoghq.com;ap1.datadoghq.com;ddog-gov.com
+
+	// Site is the Datadog site to send metrics to.
+	Site string `json:"site"`
+
+	// Tags are custom tags sent along with the metrics.
+	Tags []DatadogTag `json:"tags,omitempty"`
+
+	// DisableConsumerStats disables Kafka consumer group metrics.
+	DisableConsumerStats bool `json:"disableConsumerStats,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// KafkaConsumerCheckInstances is the number of separate instances to fetch Kafka consumer statistics with.
+	KafkaConsumerCheckInstances int64 `json:"kafkaConsumerCheckInstances,omitempty"`
+
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=300
+
+	// KafkaConsumerStatsTimeout is the number of seconds Datadog waits to get consumer statistics from brokers.
+	KafkaConsumerStatsTimeout int64 `json:"kafkaConsumerStatsTimeout,omitempty"`
+
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=200000
+
+	// MaxPartitionContexts is the maximum number of partition contexts to send.
+	MaxPartitionContexts int64 `json:"maxPartitionContexts,omitempty"`
+}
+
+// IndexEndpointSettings are the settings of an elasticsearch or opensearch endpoint.
+type IndexEndpointSettings struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1000
+
+	// IndexPrefix is the prefix of the indices the logs are written to.
+	IndexPrefix string `json:"indexPrefix"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+
+	// IndexDaysMax is the maximum number of days of logs to keep.
+	IndexDaysMax int64 `json:"indexDaysMax,omitempty"`
+
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=120
+
+	// Timeout is the request timeout in seconds.
+	Timeout int64 `json:"timeout,omitempty"`
+}
+
+// RsyslogEndpointSettings are the settings of a rsyslog endpoint.
+type RsyslogEndpointSettings struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=4
+	// +kubebuilder:validation:MaxLength=255
+
+	// Server is the IP address or hostname of the rsyslog server.
+	Server string `json:"server"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+
+	// Port is the port of the rsyslog server.
+	Port int64 `json:"port"`
+
+	// TLS requires TLS for the connection to the server.
+	TLS bool `json:"tls,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=rfc5424;rfc3164;custom
+
+	// Format is the format of the log messages.
+	Format string `json:"format"`
+
+	// Logline is the custom log message format, used with format "custom".
+	Logline string `json:"logline,omitempty"`
+
+	// SD is the structured data block of the log messages.
+	SD string `json:"sd,omitempty"`
+
+	// +kubebuilder:validation:Minimum=2048
+
+	// MaxMessageSize is the maximum size of a log message.
+	MaxMessageSize int64 `json:"maxMessageSize,omitempty"`
+}
+
+// DBaaSExternalEndpointParameters are the configurable fields of a DBaaSExternalEndpoint.
+type DBaaSExternalEndpointParameters struct {
+	// +kubebuilder:validation:Required
+
+	// Zone is the datacenter identifier in which the endpoint is available.
+	Zone Zone `json:"zone"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=prometheus;datadog;elasticsearch;opensearch;rsyslog
+
+	// Type is the type of the endpoint.
+	// Cannot be changed after the endpoint is created.
+	Type string `json:"type"`
+
+	// CredentialsSecretRef references the secret containing the credentials of the endpoint.
+	// The expected keys depend on the type:
+	// prometheus: "username" and "password" for basic auth (optional),
+	// datadog: "apiKey",
+	// elasticsearch and opensearch: "url" including credentials and optionally "ca.crt",
+	// rsyslog: "ca.crt", "tls.crt" and "tls.key" (optional).
+	CredentialsSecretRef *xpv1.SecretReference `json:"credentialsSecretRef,omitempty"`
+
+	// Datadog contains the settings of a datadog endpoint.
+	Datadog *DatadogEndpointSettings `json:"datadog,omitempty"`
+
+	// Elasticsearch contains the settings of an elasticsearch endpoint.
+	Elasticsearch *IndexEndpointSettings `json:"elasticsearch,omitempty"`
+
+	// OpenSearch contains the settings of an opensearch endpoint.
+	OpenSearch *IndexEndpointSettings `json:"opensearch,omitempty"`
+
+	// Rsyslog contains the settings of a rsyslog endpoint.
+	Rsyslog *RsyslogEndpointSettings `json:"rsyslog,omitempty"`
+}
+
+// DBaaSExternalEndpointSpec defines the desired state of a DBaaSExternalEndpoint.
+type DBaaSExternalEndpointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DBaaSExternalEndpointParameters `json:"forProvider"`
+}
+
+// DBaaSExternalEndpointObservation are the observable fields of a DBaaSExternalEndpoint.
+type DBaaSExternalEndpointObservation struct {
+	// ID is the identifier of the endpoint.
+	ID string `json:"id,omitempty"`
+	// Name is the name of the endpoint.
+	Name string `json:"name,omitempty"`
+	// Type is the type of the endpoint.
+	Type string `json:"type,omitempty"`
+	// CredentialsVersion is the resource version of the credentials secret last applied to the endpoint.
+	CredentialsVersion string `json:"credentialsVersion,omitempty"`
+}
+
+// DBaaSExternalEndpointStatus represents the observed state of a DBaaSExternalEndpoint.
+type DBaaSExternalEndpointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DBaaSExternalEndpointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-dbaasexternalendpoint,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=dbaasexternalendpoints,versions=v1,name=dbaasexternalendpoints.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// DBaaSExternalEndpoint is the API for shipping metrics and logs of DBaaS services to external systems.
+type DBaaSExternalEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBaaSExternalEndpointSpec   `json:"spec"`
+	Status DBaaSExternalEndpointStatus `json:"status,omitempty"`
+}
+
+// GetProviderConfigName returns the name of the ProviderConfig.
+// Returns empty string if reference not given.
+func (in *DBaaSExternalEndpoint) GetProviderConfigName() string {
+	if ref := in.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// GetEndpointName returns the external name of the endpoint in the following precedence:
+//
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *DBaaSExternalEndpoint) GetEndpointName() string {
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// DBaaSExternalEndpointList contains a list of DBaaSExternalEndpoint
+type DBaaSExternalEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBaaSExternalEndpoint `json:"items"`
+}
+
+// DBaaSExternalEndpoint type metadata.
+var (
+	DBaaSExternalEndpointKind             = reflect.TypeOf(DBaaSExternalEndpoint{}).Name()
+	DBaaSExternalEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: DBaaSExternalEndpointKind}.String()
+	DBaaSExternalEndpointKindAPIVersion   = DBaaSExternalEndpointKind + "." + SchemeGroupVersion.String()
+	DBaaSExternalEndpointGroupVersionKind = SchemeGroupVersion.WithKind(DBaaSExternalEndpointKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DBaaSExternalEndpoint{}, &DBaaSExternalEndpointList{})
+}
@@ -0,0 +1,138 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// DBaaSServiceReference references a DBaaS managed resource.
+type DBaaSServiceReference struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=PostgreSQL;MySQL;OpenSearch;Grafana
+
+	// Kind is the kind of the referenced managed resource.
+	Kind string `json:"kind"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+
+	// Name is the name of the referenced managed resource.
+	Name string `json:"name"`
+}
+
+// DBaaSIntegrationParameters are the configurable fields of a DBaaSIntegration.
+type DBaaSIntegrationParameters struct {
+	// +kubebuilder:validation:Required
+
+	// Zone is the datacenter identifier in which the services run in.
+	Zone Zone `json:"zone"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=datasource;logs;metrics
+
+	// IntegrationType is the type of the integration.
+	// Cannot be changed after the integration is created.
+	// Read replicas can't be set up between existing services, see `readReplicaOf` of PostgreSQL and MySQL instead.
+	IntegrationType string `json:"integrationType"`
+
+	// +kubebuilder:validation:Required
+
+	// Source references the service the integration starts from.
+	// Cannot be changed after the integration is created.
+	Source DBaaSServiceReference `json:"source"`
+
+	// +kubebuilder:validation:Required
+
+	// Destination references the service the integration points to.
+	// Cannot be changed after the integration is created.
+	Destination DBaaSServiceReference `json:"destination"`
+
+	// Settings contains additional integration settings.
+	Settings runtime.RawExtension `json:"settings,omitempty"`
+}
+
+// DBaaSIntegrationSpec defines the desired state of a DBaaSIntegration.
+type DBaaSIntegrationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DBaaSIntegrationParameters `json:"forProvider"`
+}
+
+// DBaaSIntegrationObservation are the observable fields of a DBaaSIntegration.
+type DBaaSIntegrationObservation struct {
+	// ID is the identifier of the integration.
+	ID string `json:"id,omitempty"`
+	// Type is the type of the integration.
+	Type string `json:"type,omitempty"`
+	// SourceService is the name of the source service.
+	SourceService string `json:"sourceService,omitempty"`
+	// DestinationService is the name of the destination service.
+	DestinationService string `json:"destinationService,omitempty"`
+	// Active is whether the integration is active.
+	Active bool `json:"active,omitempty"`
+	// Enabled is whether the integration is enabled.
+	Enabled bool `json:"enabled,omitempty"`
+	// Status is the status of the integration as reported by the provider.
+	Status string `json:"status,omitempty"`
+	// Settings contains additional integration settings as set by the provider.
+	Settings runtime.RawExtension `json:"settings,omitempty"`
+}
+
+// DBaaSIntegrationStatus represents the observed state of a DBaaSIntegration.
+type DBaaSIntegrationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DBaaSIntegrationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.forProvider.integrationType"
+// +kubebuilder:printcolumn:name="Source",type="string",JSONPath=".status.atProvider.sourceService"
+// +kubebuilder:printcolumn:name="Destination",type="string",JSONPath=".status.atProvider.destinationService"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-dbaasintegration,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=dbaasintegrations,versions=v1,name=dbaasintegrations.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// DBaaSIntegration is the API for integrating DBaaS services with each other.
+type DBaaSIntegration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBaaSIntegrationSpec   `json:"spec"`
+	Status DBaaSIntegrationStatus `json:"status,omitempty"`
+}
+
+// GetProviderConfigName returns the name of the ProviderConfig.
+// Returns empty string if reference not given.
+func (in *DBaaSIntegration) GetProviderConfigName() string {
+	if ref := in.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// +kubebuilder:object:root=true
+
+// DBaaSIntegrationList contains a list of DBaaSIntegration
+type DBaaSIntegrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBaaSIntegration `json:"items"`
+}
+
+// DBaaSIntegration type metadata.
+var (
+	DBaaSIntegrationKind             = reflect.TypeOf(DBaaSIntegration{}).Name()
+	DBaaSIntegrationGroupKind        = schema.GroupKind{Group: Group, Kind: DBaaSIntegrationKind}.String()
+	DBaaSIntegrationKindAPIVersion   = DBaaSIntegrationKind + "." + SchemeGroupVersion.String()
+	DBaaSIntegrationGroupVersionKind = SchemeGroupVersion.WithKind(DBaaSIntegrationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DBaaSIntegration{}, &DBaaSIntegrationList{})
+}
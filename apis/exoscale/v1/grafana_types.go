@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GrafanaParameters are the configurable fields of a Grafana instance.
+type GrafanaParameters struct {
+	Maintenance MaintenanceSpec `json:"maintenance,omitempty"`
+
+	// +kubebuilder:validation:Required
+
+	// Zone is the datacenter identifier in which the instance runs in.
+	Zone Zone `json:"zone"`
+
+	DBaaSParameters `json:",inline"`
+
+	// GrafanaSettings contains additional Grafana settings.
+	GrafanaSettings runtime.RawExtension `json:"grafanaSettings,omitempty"`
+
+	// +kubebuilder:validation:Enum=Full;ManagedKeysOnly
+	// +kubebuilder:default="Full"
+
+	// SettingsPolicy determines which settings are compared with the observed settings.
+	SettingsPolicy SettingsPolicy `json:"settingsPolicy,omitempty"`
+}
+
+// GrafanaSpec defines the desired state of a Grafana.
+type GrafanaSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GrafanaParameters `json:"forProvider"`
+}
+
+// GrafanaObservation are the observable fields of a Grafana.
+type GrafanaObservation struct {
+	Version string `json:"version,omitempty"`
+	// GrafanaSettings contains additional Grafana settings as set by the provider.
+	GrafanaSettings runtime.RawExtension `json:"grafanaSettings,omitempty"`
+
+	// State of individual service nodes
+	NodeStates []NodeState `json:"nodeStates,omitempty"`
+
+	// Service notifications
+	Notifications []Notification `json:"notifications,omitempty"`
+
+	// Triggers are the outcomes of the operations triggered by annotation.
+	Triggers []TriggerObservation `json:"triggers,omitempty"`
+}
+
+// GrafanaStatus represents the observed state of a Grafana instance.
+type GrafanaStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GrafanaObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].reason"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Plan",type="string",JSONPath=".spec.forProvider.size.plan"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-exoscale-crossplane-io-v1-grafana,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=grafanas,versions=v1,name=grafana.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// Grafana is the API for creating Grafana.
+type Grafana struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GrafanaSpec   `json:"spec"`
+	Status GrafanaStatus `json:"status,omitempty"`
+}
+
+// GetProviderConfigName returns the name of the ProviderConfig.
+// Returns empty string if reference not given.
+func (in *Grafana) GetProviderConfigName() string {
+	if ref := in.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// GetInstanceName returns the external name of the instance in the following precedence:
+//
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *Grafana) GetInstanceName() string {
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// GrafanaList contains a list of Grafana
+type GrafanaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Grafana `json:"items"`
+}
+
+// Grafana type metadata.
+var (
+	GrafanaKind             = reflect.TypeOf(Grafana{}).Name()
+	GrafanaGroupKind        = schema.GroupKind{Group: Group, Kind: GrafanaKind}.String()
+	GrafanaKindAPIVersion   = GrafanaKind + "." + SchemeGroupVersion.String()
+	GrafanaGroupVersionKind = SchemeGroupVersion.WithKind(GrafanaKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Grafana{}, &GrafanaList{})
+}
@@ -32,6 +32,32 @@ type KafkaParameters struct {
 
 	// KafkaRestSettings contains additional Kafka-REST settings.
 	KafkaRestSettings runtime.RawExtension `json:"kafkaRestSettings,omitempty"`
+
+	// KafkaConnectEnabled enables Kafka Connect.
+	KafkaConnectEnabled bool `json:"kafkaConnectEnabled,omitempty"`
+
+	// KafkaConnectSettings contains additional Kafka Connect settings.
+	KafkaConnectSettings runtime.RawExtension `json:"kafkaConnectSettings,omitempty"`
+
+	// SchemaRegistryEnabled enables the Schema Registry.
+	SchemaRegistryEnabled bool `json:"schemaRegistryEnabled,omitempty"`
+
+	// SchemaRegistrySettings contains additional Schema Registry settings.
+	SchemaRegistrySettings runtime.RawExtension `json:"schemaRegistrySettings,omitempty"`
+
+	// +kubebuilder:validation:Enum=Full;ManagedKeysOnly
+	// +kubebuilder:default="Full"
+
+	// SettingsPolicy determines which settings are compared with the observed settings.
+	SettingsPolicy SettingsPolicy `json:"settingsPolicy,omitempty"`
+
+	// ExternalEndpoints are the DBaaSExternalEndpoints the instance sends metrics or logs to.
+	// Attachments are not managed if not set.
+	ExternalEndpoints []ExternalEndpointAttachment `json:"externalEndpoints,omitempty"`
+
+	// MaxBackupAge is the maximum age of the most recent backup before the BackupUpToDate condition becomes False.
+	// The condition isn't reported if not set.
+	MaxBackupAge *metav1.Duration `json:"maxBackupAge,omitempty"`
 }
 
 // KafkaSpec defines the desired state of a Kafka.
@@ -52,11 +78,34 @@ type KafkaObservation struct {
 	// KafkaRestSettings contains additional Kafka-REST settings.
 	KafkaRestSettings runtime.RawExtension `json:"kafkaRestSettings,omitempty"`
 
+	// KafkaConnectEnabled is true if Kafka Connect is enabled.
+	KafkaConnectEnabled bool `json:"kafkaConnectEnabled,omitempty"`
+
+	// KafkaConnectSettings contains additional Kafka Connect settings.
+	KafkaConnectSettings runtime.RawExtension `json:"kafkaConnectSettings,omitempty"`
+
+	// SchemaRegistryEnabled is true if the Schema Registry is enabled.
+	SchemaRegistryEnabled bool `json:"schemaRegistryEnabled,omitempty"`
+
+	// SchemaRegistrySettings contains additional Schema Registry settings.
+	SchemaRegistrySettings runtime.RawExtension `json:"schemaRegistrySettings,omitempty"`
+
 	// State of individual service nodes
 	NodeStates []NodeState `json:"nodeStates,omitempty"`
 
 	// Service notifications
 	Notifications []Notification `json:"notifications,omitempty"`
+
+	// ExternalEndpoints are the external endpoints the instance is attached to.
+	ExternalEndpoints []ExternalEndpointObservation `json:"externalEndpoints,omitempty"`
+
+	// Triggers are the outcomes of the operations triggered by annotation.
+	Triggers []TriggerObservation `json:"triggers,omitempty"`
+
+	// Backups are the backups of the instance.
+	Backups []Backup `json:"backups,omitempty"`
+	// LastBackupTime is the time of the most recent backup.
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
 }
 
 // KafkaStatus represents the observed state of a Kafka instance.
@@ -74,7 +123,7 @@ type KafkaStatus struct {
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
-// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-kafka,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=kafkas,versions=v1,name=kafkas.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-exoscale-crossplane-io-v1-kafka,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=kafkas,versions=v1,name=kafkas.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
 
 // Kafka is the API for creating Kafka.
 type Kafka struct {
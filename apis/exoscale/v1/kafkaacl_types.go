@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// KafkaACLParameters are the configurable fields of a KafkaACL.
+type KafkaACLParameters struct {
+	// +kubebuilder:validation:Required
+
+	// Zone is the datacenter identifier in which the Kafka instance runs in.
+	Zone Zone `json:"zone"`
+
+	// InstanceName is the name of the Kafka instance the ACL belongs to.
+	// Cannot be changed after the ACL is created.
+	// +crossplane:generate:reference:type=Kafka
+	// +crossplane:generate:reference:refFieldName=InstanceRef
+	// +crossplane:generate:reference:selectorFieldName=InstanceSelector
+	InstanceName string `json:"instanceName,omitempty"`
+
+	// InstanceRef references the Kafka instance to retrieve its name.
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects the Kafka instance to retrieve its name.
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// Username is the name or pattern of the users the ACL grants access to.
+	// Cannot be changed after the ACL is created.
+	// +crossplane:generate:reference:type=KafkaUser
+	// +crossplane:generate:reference:refFieldName=UserRef
+	// +crossplane:generate:reference:selectorFieldName=UserSelector
+	Username string `json:"username,omitempty"`
+
+	// UserRef references the KafkaUser to retrieve its name.
+	UserRef *xpv1.Reference `json:"userRef,omitempty"`
+
+	// UserSelector selects the KafkaUser to retrieve its name.
+	UserSelector *xpv1.Selector `json:"userSelector,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=249
+
+	// Topic is the name or pattern of the topics the ACL grants access to.
+	// Cannot be changed after the ACL is created.
+	Topic string `json:"topic"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=read;write;readwrite;admin
+
+	// Permission is the access granted to the topics.
+	// Cannot be changed after the ACL is created.
+	Permission string `json:"permission"`
+}
+
+// KafkaACLSpec defines the desired state of a KafkaACL.
+type KafkaACLSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KafkaACLParameters `json:"forProvider"`
+}
+
+// KafkaACLObservation are the observable fields of a KafkaACL.
+type KafkaACLObservation struct {
+	// ID is the identifier of the ACL entry.
+	ID string `json:"id,omitempty"`
+}
+
+// KafkaACLStatus represents the observed state of a KafkaACL.
+type KafkaACLStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KafkaACLObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Instance",type="string",JSONPath=".spec.forProvider.instanceName"
+// +kubebuilder:printcolumn:name="Username",type="string",JSONPath=".spec.forProvider.username"
+// +kubebuilder:printcolumn:name="Topic",type="string",JSONPath=".spec.forProvider.topic"
+// +kubebuilder:printcolumn:name="Permission",type="string",JSONPath=".spec.forProvider.permission"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-kafkaacl,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=kafkaacls,versions=v1,name=kafkaacls.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// KafkaACL is the API for granting Kafka users access to topics.
+type KafkaACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KafkaACLSpec   `json:"spec"`
+	Status KafkaACLStatus `json:"status,omitempty"`
+}
+
+// GetProviderConfigName returns the name of the ProviderConfig.
+// Returns empty string if reference not given.
+func (in *KafkaACL) GetProviderConfigName() string {
+	if ref := in.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// +kubebuilder:object:root=true
+
+// KafkaACLList contains a list of KafkaACL
+type KafkaACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KafkaACL `json:"items"`
+}
+
+// KafkaACL type metadata.
+var (
+	KafkaACLKind             = reflect.TypeOf(KafkaACL{}).Name()
+	KafkaACLGroupKind        = schema.GroupKind{Group: Group, Kind: KafkaACLKind}.String()
+	KafkaACLKindAPIVersion   = KafkaACLKind + "." + SchemeGroupVersion.String()
+	KafkaACLGroupVersionKind = SchemeGroupVersion.WithKind(KafkaACLKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KafkaACL{}, &KafkaACLList{})
+}
@@ -0,0 +1,117 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// KafkaUserParameters are the configurable fields of a KafkaUser.
+type KafkaUserParameters struct {
+	// +kubebuilder:validation:Required
+
+	// Zone is the datacenter identifier in which the Kafka instance runs in.
+	Zone Zone `json:"zone"`
+
+	// InstanceName is the name of the Kafka instance the user belongs to.
+	// Cannot be changed after the user is created.
+	// +crossplane:generate:reference:type=Kafka
+	// +crossplane:generate:reference:refFieldName=InstanceRef
+	// +crossplane:generate:reference:selectorFieldName=InstanceSelector
+	InstanceName string `json:"instanceName,omitempty"`
+
+	// InstanceRef references the Kafka instance to retrieve its name.
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects the Kafka instance to retrieve its name.
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// PasswordSecretRef references the key of a Secret containing the password of the user.
+	// If not set, a password is generated by Exoscale.
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+}
+
+// KafkaUserSpec defines the desired state of a KafkaUser.
+type KafkaUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KafkaUserParameters `json:"forProvider"`
+}
+
+// KafkaUserObservation are the observable fields of a KafkaUser.
+type KafkaUserObservation struct {
+	// Username is the observed name of the user.
+	Username string `json:"username,omitempty"`
+	// Type of the user account.
+	Type string `json:"type,omitempty"`
+	// AccessCertExpiry is the expiry time of the access certificate of the user.
+	AccessCertExpiry *metav1.Time `json:"accessCertExpiry,omitempty"`
+}
+
+// KafkaUserStatus represents the observed state of a KafkaUser.
+type KafkaUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KafkaUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Instance",type="string",JSONPath=".spec.forProvider.instanceName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-kafkauser,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=kafkausers,versions=v1,name=kafkausers.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// KafkaUser is the API for creating users with their own credentials on a Kafka instance.
+type KafkaUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KafkaUserSpec   `json:"spec"`
+	Status KafkaUserStatus `json:"status,omitempty"`
+}
+
+// GetProviderConfigName returns the name of the ProviderConfig.
+// Returns empty string if reference not given.
+func (in *KafkaUser) GetProviderConfigName() string {
+	if ref := in.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// GetUsername returns the name of the user in the following precedence:
+//
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *KafkaUser) GetUsername() string {
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// KafkaUserList contains a list of KafkaUser
+type KafkaUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KafkaUser `json:"items"`
+}
+
+// KafkaUser type metadata.
+var (
+	KafkaUserKind             = reflect.TypeOf(KafkaUser{}).Name()
+	KafkaUserGroupKind        = schema.GroupKind{Group: Group, Kind: KafkaUserKind}.String()
+	KafkaUserKindAPIVersion   = KafkaUserKind + "." + SchemeGroupVersion.String()
+	KafkaUserGroupVersionKind = SchemeGroupVersion.WithKind(KafkaUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KafkaUser{}, &KafkaUserList{})
+}
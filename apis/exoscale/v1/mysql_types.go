@@ -27,6 +27,49 @@ type MySQLParameters struct {
 
 	// MySQLSettings contains additional MySQL settings.
 	MySQLSettings runtime.RawExtension `json:"mysqlSettings,omitempty"`
+
+	// +kubebuilder:validation:Enum=Full;ManagedKeysOnly
+	// +kubebuilder:default="Full"
+
+	// SettingsPolicy determines which settings are compared with the observed settings.
+	SettingsPolicy SettingsPolicy `json:"settingsPolicy,omitempty"`
+
+	// ForkFrom is the name of the MySQL service the instance is forked from.
+	// Only honoured when the instance is created, cannot be changed afterwards.
+	// +crossplane:generate:reference:type=MySQL
+	// +crossplane:generate:reference:refFieldName=ForkFromRef
+	// +crossplane:generate:reference:selectorFieldName=ForkFromSelector
+	ForkFrom string `json:"forkFrom,omitempty"`
+
+	// ForkFromRef references the MySQL instance to fork from.
+	ForkFromRef *xpv1.Reference `json:"forkFromRef,omitempty"`
+
+	// ForkFromSelector selects the MySQL instance to fork from.
+	ForkFromSelector *xpv1.Selector `json:"forkFromSelector,omitempty"`
+
+	// RecoveryBackupName is the name of a backup of the forked service to restore.
+	// Only honoured when the instance is created, cannot be changed afterwards.
+	RecoveryBackupName string `json:"recoveryBackupName,omitempty"`
+
+	// RecoveryTargetTime is the point in time of the forked service to restore.
+	// Only honoured when the instance is created, cannot be changed afterwards.
+	RecoveryTargetTime *metav1.Time `json:"recoveryTargetTime,omitempty"`
+
+	// ReadReplicaOf is the name of the MySQL service the instance is created as read replica of.
+	// Only honoured when the instance is created, cannot be changed afterwards.
+	ReadReplicaOf string `json:"readReplicaOf,omitempty"`
+
+	// ExternalEndpoints are the DBaaSExternalEndpoints the instance sends metrics or logs to.
+	// Attachments are not managed if not set.
+	ExternalEndpoints []ExternalEndpointAttachment `json:"externalEndpoints,omitempty"`
+
+	// MaxBackupAge is the maximum age of the most recent backup before the BackupUpToDate condition becomes False.
+	// The condition isn't reported if not set.
+	MaxBackupAge *metav1.Duration `json:"maxBackupAge,omitempty"`
+
+	// Migration migrates data from an existing database server into the instance.
+	// Removing it stops the migration.
+	Migration *MigrationSpec `json:"migration,omitempty"`
 }
 
 // MySQLSpec defines the desired state of a MySQL.
@@ -44,6 +87,20 @@ type MySQLObservation struct {
 	NodeStates      []NodeState          `json:"nodeStates,omitempty"`
 	MySQLSettings   runtime.RawExtension `json:"mysqlSettings,omitempty"`
 	Notifications   []Notification       `json:"notifications,omitempty"`
+
+	// ExternalEndpoints are the external endpoints the instance is attached to.
+	ExternalEndpoints []ExternalEndpointObservation `json:"externalEndpoints,omitempty"`
+
+	// Triggers are the outcomes of the operations triggered by annotation.
+	Triggers []TriggerObservation `json:"triggers,omitempty"`
+
+	// Backups are the backups of the instance.
+	Backups []Backup `json:"backups,omitempty"`
+	// LastBackupTime is the time of the most recent backup.
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+
+	// Migration is the observed state of the migration.
+	Migration *MigrationObservation `json:"migration,omitempty"`
 }
 
 // MySQLStatus represents the observed state of a MySQL.
@@ -61,7 +118,7 @@ type MySQLStatus struct {
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
-// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-mysql,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=mysqls,versions=v1,name=mysqls.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-exoscale-crossplane-io-v1-mysql,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=mysqls,versions=v1,name=mysqls.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
 
 // MySQL is the API for creating MySQL.
 type MySQL struct {
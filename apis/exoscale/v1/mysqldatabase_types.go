@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// MySQLDatabaseParameters are the configurable fields of a MySQLDatabase.
+type MySQLDatabaseParameters struct {
+	// +kubebuilder:validation:Required
+
+	// Zone is the datacenter identifier in which the MySQL instance runs in.
+	Zone Zone `json:"zone"`
+
+	// InstanceName is the name of the MySQL instance the database belongs to.
+	// Cannot be changed after the database is created.
+	// +crossplane:generate:reference:type=MySQL
+	// +crossplane:generate:reference:refFieldName=InstanceRef
+	// +crossplane:generate:reference:selectorFieldName=InstanceSelector
+	InstanceName string `json:"instanceName,omitempty"`
+
+	// InstanceRef references the MySQL instance to retrieve its name.
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects the MySQL instance to retrieve its name.
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// +kubebuilder:default=true
+
+	// DeletionProtection prevents the database from being dropped.
+	// Deleting the resource is rejected while it's enabled, unless the deletion policy is `Orphan`
+	// or the annotation `exoscale.crossplane.io/allow-deletion` is set to "true".
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+}
+
+// MySQLDatabaseSpec defines the desired state of a MySQLDatabase.
+type MySQLDatabaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MySQLDatabaseParameters `json:"forProvider"`
+}
+
+// MySQLDatabaseObservation are the observable fields of a MySQLDatabase.
+type MySQLDatabaseObservation struct {
+	// DatabaseName is the observed name of the database.
+	DatabaseName string `json:"databaseName,omitempty"`
+}
+
+// MySQLDatabaseStatus represents the observed state of a MySQLDatabase.
+type MySQLDatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MySQLDatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Instance",type="string",JSONPath=".spec.forProvider.instanceName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-exoscale-crossplane-io-v1-mysqldatabase,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=mysqldatabases,versions=v1,name=mysqldatabases.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// MySQLDatabase is the API for creating logical databases on a MySQL instance.
+type MySQLDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MySQLDatabaseSpec   `json:"spec"`
+	Status MySQLDatabaseStatus `json:"status,omitempty"`
+}
+
+// GetProviderConfigName returns the name of the ProviderConfig.
+// Returns empty string if reference not given.
+func (in *MySQLDatabase) GetProviderConfigName() string {
+	if ref := in.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// GetDatabaseName returns the name of the database in the following precedence:
+//
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *MySQLDatabase) GetDatabaseName() string {
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// MySQLDatabaseList contains a list of MySQLDatabase
+type MySQLDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MySQLDatabase `json:"items"`
+}
+
+// MySQLDatabase type metadata.
+var (
+	MySQLDatabaseKind             = reflect.TypeOf(MySQLDatabase{}).Name()
+	MySQLDatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: MySQLDatabaseKind}.String()
+	MySQLDatabaseKindAPIVersion   = MySQLDatabaseKind + "." + SchemeGroupVersion.String()
+	MySQLDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(MySQLDatabaseKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MySQLDatabase{}, &MySQLDatabaseList{})
+}
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestMySQLDatabaseParameters_DeletionProtection(t *testing.T) {
+	// Disabled protection must survive writes, otherwise the API server defaults it to true again.
+	raw, err := json.Marshal(MySQLDatabaseParameters{DeletionProtection: ptr.To(false)})
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), `"deletionProtection":false`)
+
+	params := MySQLDatabaseParameters{}
+	require.NoError(t, json.Unmarshal(raw, &params))
+	assert.Equal(t, ptr.To(false), params.DeletionProtection)
+}
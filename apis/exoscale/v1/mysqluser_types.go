@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// MySQLUserParameters are the configurable fields of a MySQLUser.
+type MySQLUserParameters struct {
+	// +kubebuilder:validation:Required
+
+	// Zone is the datacenter identifier in which the MySQL instance runs in.
+	Zone Zone `json:"zone"`
+
+	// InstanceName is the name of the MySQL instance the user belongs to.
+	// Cannot be changed after the user is created.
+	// +crossplane:generate:reference:type=MySQL
+	// +crossplane:generate:reference:refFieldName=InstanceRef
+	// +crossplane:generate:reference:selectorFieldName=InstanceSelector
+	InstanceName string `json:"instanceName,omitempty"`
+
+	// InstanceRef references the MySQL instance to retrieve its name.
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects the MySQL instance to retrieve its name.
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// +kubebuilder:validation:Enum=caching_sha2_password;mysql_native_password
+
+	// AuthenticationPlugin is the authentication plugin of the user.
+	// If not set, the default plugin of the instance is used.
+	AuthenticationPlugin string `json:"authenticationPlugin,omitempty"`
+
+	// PasswordSecretRef references the key of a Secret containing the password of the user.
+	// If not set, a password is generated by Exoscale.
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+}
+
+// MySQLUserSpec defines the desired state of a MySQLUser.
+type MySQLUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MySQLUserParameters `json:"forProvider"`
+}
+
+// MySQLUserObservation are the observable fields of a MySQLUser.
+type MySQLUserObservation struct {
+	// Username is the observed name of the user.
+	Username string `json:"username,omitempty"`
+	// Type of the user account.
+	Type string `json:"type,omitempty"`
+	// AuthenticationPlugin is the authentication plugin of the user.
+	AuthenticationPlugin string `json:"authenticationPlugin,omitempty"`
+}
+
+// MySQLUserStatus represents the observed state of a MySQLUser.
+type MySQLUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MySQLUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Instance",type="string",JSONPath=".spec.forProvider.instanceName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-mysqluser,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=mysqlusers,versions=v1,name=mysqlusers.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// MySQLUser is the API for creating users on a MySQL instance.
+type MySQLUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MySQLUserSpec   `json:"spec"`
+	Status MySQLUserStatus `json:"status,omitempty"`
+}
+
+// GetProviderConfigName returns the name of the ProviderConfig.
+// Returns empty string if reference not given.
+func (in *MySQLUser) GetProviderConfigName() string {
+	if ref := in.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// GetUsername returns the name of the user in the following precedence:
+//
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *MySQLUser) GetUsername() string {
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// MySQLUserList contains a list of MySQLUser
+type MySQLUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MySQLUser `json:"items"`
+}
+
+// MySQLUser type metadata.
+var (
+	MySQLUserKind             = reflect.TypeOf(MySQLUser{}).Name()
+	MySQLUserGroupKind        = schema.GroupKind{Group: Group, Kind: MySQLUserKind}.String()
+	MySQLUserKindAPIVersion   = MySQLUserKind + "." + SchemeGroupVersion.String()
+	MySQLUserGroupVersionKind = SchemeGroupVersion.WithKind(MySQLUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MySQLUser{}, &MySQLUserList{})
+}
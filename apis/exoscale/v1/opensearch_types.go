@@ -21,6 +21,28 @@ type OpenSearchParameters struct {
 	// majorVersion - supported versions are "1" and "2" (string)
 	MajorVersion       string               `json:"majorVersion,omitempty"`
 	OpenSearchSettings runtime.RawExtension `json:"openSearchSettings,omitempty"`
+
+	// +kubebuilder:validation:Enum=Full;ManagedKeysOnly
+	// +kubebuilder:default="Full"
+
+	// SettingsPolicy determines which settings are compared with the observed settings.
+	SettingsPolicy SettingsPolicy `json:"settingsPolicy,omitempty"`
+
+	// ACLEnabled enables the access control rules of OpenSearchUsers.
+	// When disabled, authenticated users have unrestricted access.
+	// The flag is not managed if not set.
+	ACLEnabled *bool `json:"aclEnabled,omitempty"`
+	// ExtendedACLEnabled enforces index rules in a limited fashion for requests that use the _mget, _msearch, and _bulk APIs.
+	// The flag is not managed if not set.
+	ExtendedACLEnabled *bool `json:"extendedAclEnabled,omitempty"`
+
+	// ExternalEndpoints are the DBaaSExternalEndpoints the instance sends metrics or logs to.
+	// Attachments are not managed if not set.
+	ExternalEndpoints []ExternalEndpointAttachment `json:"externalEndpoints,omitempty"`
+
+	// MaxBackupAge is the maximum age of the most recent backup before the BackupUpToDate condition becomes False.
+	// The condition isn't reported if not set.
+	MaxBackupAge *metav1.Duration `json:"maxBackupAge,omitempty"`
 }
 
 // OpenSearchSpec defines the desired state of a OpenSearch.
@@ -41,6 +63,22 @@ type OpenSearchObservation struct {
 	// Service notifications
 	Notifications []Notification  `json:"notifications,omitempty"`
 	Maintenance   MaintenanceSpec `json:"maintenance"`
+
+	// ACLEnabled is true if access control rules are enforced.
+	ACLEnabled bool `json:"aclEnabled,omitempty"`
+	// ExtendedACLEnabled is true if index rules are enforced for _mget, _msearch, and _bulk requests.
+	ExtendedACLEnabled bool `json:"extendedAclEnabled,omitempty"`
+
+	// ExternalEndpoints are the external endpoints the instance is attached to.
+	ExternalEndpoints []ExternalEndpointObservation `json:"externalEndpoints,omitempty"`
+
+	// Triggers are the outcomes of the operations triggered by annotation.
+	Triggers []TriggerObservation `json:"triggers,omitempty"`
+
+	// Backups are the backups of the instance.
+	Backups []Backup `json:"backups,omitempty"`
+	// LastBackupTime is the time of the most recent backup.
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
 }
 
 // OpenSearchStatus represents the observed state of a OpenSearch instance.
@@ -58,7 +96,7 @@ type OpenSearchStatus struct {
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
-// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-opensearch,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=opensearches,versions=v1,name=opensearch.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-exoscale-crossplane-io-v1-opensearch,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=opensearches,versions=v1,name=opensearch.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
 
 // OpenSearch is the API for creating OpenSearch.
 type OpenSearch struct {
@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// OpenSearchUserParameters are the configurable fields of a OpenSearchUser.
+type OpenSearchUserParameters struct {
+	// +kubebuilder:validation:Required
+
+	// Zone is the datacenter identifier in which the OpenSearch instance runs in.
+	Zone Zone `json:"zone"`
+
+	// InstanceName is the name of the OpenSearch instance the user belongs to.
+	// Cannot be changed after the user is created.
+	// +crossplane:generate:reference:type=OpenSearch
+	// +crossplane:generate:reference:refFieldName=InstanceRef
+	// +crossplane:generate:reference:selectorFieldName=InstanceSelector
+	InstanceName string `json:"instanceName,omitempty"`
+
+	// InstanceRef references the OpenSearch instance to retrieve its name.
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects the OpenSearch instance to retrieve its name.
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// PasswordSecretRef references the key of a Secret containing the password of the user.
+	// If not set, a password is generated by Exoscale.
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// ACLRules grant the user access to indices.
+	// The rules are only enforced if ACLs are enabled on the OpenSearch instance.
+	ACLRules []OpenSearchACLRule `json:"aclRules,omitempty"`
+}
+
+// OpenSearchACLRule grants access to indices matching a pattern.
+type OpenSearchACLRule struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=249
+
+	// Index is the name or pattern of the indices the rule applies to.
+	Index string `json:"index"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=read;write;readwrite;admin;deny
+
+	// Permission is the access granted to the indices.
+	Permission string `json:"permission"`
+}
+
+// OpenSearchUserSpec defines the desired state of a OpenSearchUser.
+type OpenSearchUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OpenSearchUserParameters `json:"forProvider"`
+}
+
+// OpenSearchUserObservation are the observable fields of a OpenSearchUser.
+type OpenSearchUserObservation struct {
+	// Username is the observed name of the user.
+	Username string `json:"username,omitempty"`
+	// Type of the user account.
+	Type string `json:"type,omitempty"`
+	// ACLRules are the observed access control rules of the user.
+	ACLRules []OpenSearchACLRule `json:"aclRules,omitempty"`
+}
+
+// OpenSearchUserStatus represents the observed state of a OpenSearchUser.
+type OpenSearchUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OpenSearchUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Instance",type="string",JSONPath=".spec.forProvider.instanceName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-opensearchuser,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=opensearchusers,versions=v1,name=opensearchusers.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// OpenSearchUser is the API for creating users with scoped access on an OpenSearch instance.
+type OpenSearchUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenSearchUserSpec   `json:"spec"`
+	Status OpenSearchUserStatus `json:"status,omitempty"`
+}
+
+// GetProviderConfigName returns the name of the ProviderConfig.
+// Returns empty string if reference not given.
+func (in *OpenSearchUser) GetProviderConfigName() string {
+	if ref := in.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// GetUsername returns the name of the user in the following precedence:
+//
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *OpenSearchUser) GetUsername() string {
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// OpenSearchUserList contains a list of OpenSearchUser
+type OpenSearchUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenSearchUser `json:"items"`
+}
+
+// OpenSearchUser type metadata.
+var (
+	OpenSearchUserKind             = reflect.TypeOf(OpenSearchUser{}).Name()
+	OpenSearchUserGroupKind        = schema.GroupKind{Group: Group, Kind: OpenSearchUserKind}.String()
+	OpenSearchUserKindAPIVersion   = OpenSearchUserKind + "." + SchemeGroupVersion.String()
+	OpenSearchUserGroupVersionKind = SchemeGroupVersion.WithKind(OpenSearchUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OpenSearchUser{}, &OpenSearchUserList{})
+}
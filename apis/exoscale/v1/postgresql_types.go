@@ -26,6 +26,75 @@ type PostgreSQLParameters struct {
 
 	// PGSettings contains additional PostgreSQL settings.
 	PGSettings runtime.RawExtension `json:"pgSettings,omitempty"`
+
+	// PgBouncerSettings contains the system-wide PgBouncer connection pooling settings.
+	PgBouncerSettings runtime.RawExtension `json:"pgbouncerSettings,omitempty"`
+
+	// PGLookoutSettings contains the system-wide PGLookout settings.
+	PGLookoutSettings runtime.RawExtension `json:"pglookoutSettings,omitempty"`
+
+	// TimescaleDBSettings contains the system-wide settings of the TimescaleDB extension.
+	TimescaleDBSettings runtime.RawExtension `json:"timescaledbSettings,omitempty"`
+
+	// +kubebuilder:validation:Enum=Full;ManagedKeysOnly
+	// +kubebuilder:default="Full"
+
+	// SettingsPolicy determines which settings are compared with the observed settings.
+	SettingsPolicy SettingsPolicy `json:"settingsPolicy,omitempty"`
+
+	// SharedBuffersPercentage is the percentage of total RAM that the database server uses for shared memory buffers.
+	// The provider's default is used if not set.
+	// +kubebuilder:validation:Minimum=20
+	// +kubebuilder:validation:Maximum=60
+	SharedBuffersPercentage int64 `json:"sharedBuffersPercentage,omitempty"`
+
+	// WorkMem is the amount of memory in MB used by internal sort operations and hash tables before writing to temporary disk files.
+	// The provider's default is used if not set.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1024
+	WorkMem int64 `json:"workMem,omitempty"`
+
+	// SynchronousReplication enables synchronous replication between the nodes of the instance.
+	// The provider's default is used if not set.
+	// +kubebuilder:validation:Enum=quorum;off
+	SynchronousReplication string `json:"synchronousReplication,omitempty"`
+
+	// ForkFrom is the name of the PostgreSQL service the instance is forked from.
+	// Only honoured when the instance is created, cannot be changed afterwards.
+	// +crossplane:generate:reference:type=PostgreSQL
+	// +crossplane:generate:reference:refFieldName=ForkFromRef
+	// +crossplane:generate:reference:selectorFieldName=ForkFromSelector
+	ForkFrom string `json:"forkFrom,omitempty"`
+
+	// ForkFromRef references the PostgreSQL instance to fork from.
+	ForkFromRef *xpv1.Reference `json:"forkFromRef,omitempty"`
+
+	// ForkFromSelector selects the PostgreSQL instance to fork from.
+	ForkFromSelector *xpv1.Selector `json:"forkFromSelector,omitempty"`
+
+	// RecoveryBackupName is the name of a backup of the forked service to restore.
+	// Only honoured when the instance is created, cannot be changed afterwards.
+	RecoveryBackupName string `json:"recoveryBackupName,omitempty"`
+
+	// RecoveryTargetTime is the point in time of the forked service to restore.
+	// Only honoured when the instance is created, cannot be changed afterwards.
+	RecoveryTargetTime *metav1.Time `json:"recoveryTargetTime,omitempty"`
+
+	// ReadReplicaOf is the name of the PostgreSQL service the instance is created as read replica of.
+	// Only honoured when the instance is created, cannot be changed afterwards.
+	ReadReplicaOf string `json:"readReplicaOf,omitempty"`
+
+	// ExternalEndpoints are the DBaaSExternalEndpoints the instance sends metrics or logs to.
+	// Attachments are not managed if not set.
+	ExternalEndpoints []ExternalEndpointAttachment `json:"externalEndpoints,omitempty"`
+
+	// MaxBackupAge is the maximum age of the most recent backup before the BackupUpToDate condition becomes False.
+	// The condition isn't reported if not set.
+	MaxBackupAge *metav1.Duration `json:"maxBackupAge,omitempty"`
+
+	// Migration migrates data from an existing database server into the instance.
+	// Removing it stops the migration.
+	Migration *MigrationSpec `json:"migration,omitempty"`
 }
 
 // PostgreSQLSpec defines the desired state of a PostgreSQL.
@@ -43,6 +112,52 @@ type PostgreSQLObservation struct {
 	Backup      BackupSpec           `json:"backup,omitempty"`
 	NodeStates  []NodeState          `json:"nodeStates,omitempty"`
 	PGSettings  runtime.RawExtension `json:"pgSettings,omitempty"`
+
+	// PgBouncerSettings contains the system-wide PgBouncer connection pooling settings.
+	PgBouncerSettings runtime.RawExtension `json:"pgbouncerSettings,omitempty"`
+	// PGLookoutSettings contains the system-wide PGLookout settings.
+	PGLookoutSettings runtime.RawExtension `json:"pglookoutSettings,omitempty"`
+	// TimescaleDBSettings contains the system-wide settings of the TimescaleDB extension.
+	TimescaleDBSettings runtime.RawExtension `json:"timescaledbSettings,omitempty"`
+	// SharedBuffersPercentage is the percentage of total RAM that the database server uses for shared memory buffers.
+	SharedBuffersPercentage int64 `json:"sharedBuffersPercentage,omitempty"`
+	// WorkMem is the amount of memory in MB used by internal sort operations and hash tables.
+	WorkMem int64 `json:"workMem,omitempty"`
+	// SynchronousReplication is the synchronous replication mode of the instance.
+	SynchronousReplication string `json:"synchronousReplication,omitempty"`
+
+	// ExternalEndpoints are the external endpoints the instance is attached to.
+	ExternalEndpoints []ExternalEndpointObservation `json:"externalEndpoints,omitempty"`
+
+	// Notifications are the service messages of the instance.
+	Notifications []Notification `json:"notifications,omitempty"`
+
+	// Triggers are the outcomes of the operations triggered by annotation.
+	Triggers []TriggerObservation `json:"triggers,omitempty"`
+
+	// Backups are the backups of the instance.
+	Backups []Backup `json:"backups,omitempty"`
+	// LastBackupTime is the time of the most recent backup.
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
+
+	// Migration is the observed state of the migration.
+	Migration *MigrationObservation `json:"migration,omitempty"`
+
+	// Upgrade tracks a major version upgrade of the instance.
+	Upgrade *PostgreSQLUpgradeStatus `json:"upgrade,omitempty"`
+}
+
+// PostgreSQLUpgradeStatus tracks a major version upgrade of a PostgreSQL.
+type PostgreSQLUpgradeStatus struct {
+	// TargetVersion is the major version the instance is upgraded to.
+	TargetVersion string `json:"targetVersion,omitempty"`
+	// CheckTaskID is the identifier of the pre-flight upgrade check.
+	CheckTaskID string `json:"checkTaskID,omitempty"`
+	// CheckFailed is true if the pre-flight upgrade check failed.
+	// The check isn't run again until another version is requested.
+	CheckFailed bool `json:"checkFailed,omitempty"`
+	// CheckResult is the result of the failed pre-flight upgrade check.
+	CheckResult string `json:"checkResult,omitempty"`
 }
 
 // PostgreSQLStatus represents the observed state of a PostgreSQL.
@@ -60,7 +175,7 @@ type PostgreSQLStatus struct {
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
-// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-postgresql,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=postgresqls,versions=v1,name=postgresqls.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-exoscale-crossplane-io-v1-postgresql,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=postgresqls,versions=v1,name=postgresqls.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
 
 // PostgreSQL is the API for creating PostgreSQL.
 type PostgreSQL struct {
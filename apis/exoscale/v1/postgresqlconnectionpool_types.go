@@ -0,0 +1,158 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PostgreSQLConnectionPoolParameters are the configurable fields of a PostgreSQLConnectionPool.
+type PostgreSQLConnectionPoolParameters struct {
+	// +kubebuilder:validation:Required
+
+	// Zone is the datacenter identifier in which the PostgreSQL instance runs in.
+	Zone Zone `json:"zone"`
+
+	// InstanceName is the name of the PostgreSQL instance the connection pool belongs to.
+	// Cannot be changed after the connection pool is created.
+	// +crossplane:generate:reference:type=PostgreSQL
+	// +crossplane:generate:reference:refFieldName=InstanceRef
+	// +crossplane:generate:reference:selectorFieldName=InstanceSelector
+	InstanceName string `json:"instanceName,omitempty"`
+
+	// InstanceRef references the PostgreSQL instance to retrieve its name.
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects the PostgreSQL instance to retrieve its name.
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// DatabaseName is the name of the database the pool connects to.
+	// +crossplane:generate:reference:type=PostgreSQLDatabase
+	// +crossplane:generate:reference:refFieldName=DatabaseRef
+	// +crossplane:generate:reference:selectorFieldName=DatabaseSelector
+	DatabaseName string `json:"databaseName,omitempty"`
+
+	// DatabaseRef references the PostgreSQLDatabase to retrieve its name.
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects the PostgreSQLDatabase to retrieve its name.
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// Username is the name of the user the pool connects with.
+	// If not set, the pool connects with the credentials of the client.
+	// +crossplane:generate:reference:type=PostgreSQLUser
+	// +crossplane:generate:reference:refFieldName=UserRef
+	// +crossplane:generate:reference:selectorFieldName=UserSelector
+	Username string `json:"username,omitempty"`
+
+	// UserRef references the PostgreSQLUser to retrieve its name.
+	UserRef *xpv1.Reference `json:"userRef,omitempty"`
+
+	// UserSelector selects the PostgreSQLUser to retrieve its name.
+	UserSelector *xpv1.Selector `json:"userSelector,omitempty"`
+
+	// +kubebuilder:validation:Enum=session;transaction;statement
+	// +kubebuilder:default=transaction
+
+	// Mode is the PgBouncer pool mode.
+	Mode string `json:"mode,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// +kubebuilder:default=10
+
+	// Size is the number of server connections of the pool.
+	Size int64 `json:"size,omitempty"`
+}
+
+// PostgreSQLConnectionPoolSpec defines the desired state of a PostgreSQLConnectionPool.
+type PostgreSQLConnectionPoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PostgreSQLConnectionPoolParameters `json:"forProvider"`
+}
+
+// PostgreSQLConnectionPoolObservation are the observable fields of a PostgreSQLConnectionPool.
+type PostgreSQLConnectionPoolObservation struct {
+	// Name is the observed name of the connection pool.
+	Name string `json:"name,omitempty"`
+	// DatabaseName is the name of the database the pool connects to.
+	DatabaseName string `json:"databaseName,omitempty"`
+	// Username is the name of the user the pool connects with.
+	Username string `json:"username,omitempty"`
+	// Mode is the PgBouncer pool mode.
+	Mode string `json:"mode,omitempty"`
+	// Size is the number of server connections of the pool.
+	Size int64 `json:"size,omitempty"`
+}
+
+// PostgreSQLConnectionPoolStatus represents the observed state of a PostgreSQLConnectionPool.
+type PostgreSQLConnectionPoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PostgreSQLConnectionPoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Instance",type="string",JSONPath=".spec.forProvider.instanceName"
+// +kubebuilder:printcolumn:name="Database",type="string",JSONPath=".spec.forProvider.databaseName"
+// +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=".spec.forProvider.mode"
+// +kubebuilder:printcolumn:name="Size",type="integer",JSONPath=".spec.forProvider.size"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-postgresqlconnectionpool,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=postgresqlconnectionpools,versions=v1,name=postgresqlconnectionpools.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// PostgreSQLConnectionPool is the API for creating PgBouncer connection pools on a PostgreSQL instance.
+type PostgreSQLConnectionPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgreSQLConnectionPoolSpec   `json:"spec"`
+	Status PostgreSQLConnectionPoolStatus `json:"status,omitempty"`
+}
+
+// GetProviderConfigName returns the name of the ProviderConfig.
+// Returns empty string if reference not given.
+func (in *PostgreSQLConnectionPool) GetProviderConfigName() string {
+	if ref := in.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// GetPoolName returns the name of the connection pool in the following precedence:
+//
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *PostgreSQLConnectionPool) GetPoolName() string {
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLConnectionPoolList contains a list of PostgreSQLConnectionPool
+type PostgreSQLConnectionPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLConnectionPool `json:"items"`
+}
+
+// PostgreSQLConnectionPool type metadata.
+var (
+	PostgreSQLConnectionPoolKind             = reflect.TypeOf(PostgreSQLConnectionPool{}).Name()
+	PostgreSQLConnectionPoolGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLConnectionPoolKind}.String()
+	PostgreSQLConnectionPoolKindAPIVersion   = PostgreSQLConnectionPoolKind + "." + SchemeGroupVersion.String()
+	PostgreSQLConnectionPoolGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLConnectionPoolKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PostgreSQLConnectionPool{}, &PostgreSQLConnectionPoolList{})
+}
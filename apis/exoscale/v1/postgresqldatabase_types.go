@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PostgreSQLDatabaseParameters are the configurable fields of a PostgreSQLDatabase.
+type PostgreSQLDatabaseParameters struct {
+	// +kubebuilder:validation:Required
+
+	// Zone is the datacenter identifier in which the PostgreSQL instance runs in.
+	Zone Zone `json:"zone"`
+
+	// InstanceName is the name of the PostgreSQL instance the database belongs to.
+	// Cannot be changed after the database is created.
+	// +crossplane:generate:reference:type=PostgreSQL
+	// +crossplane:generate:reference:refFieldName=InstanceRef
+	// +crossplane:generate:reference:selectorFieldName=InstanceSelector
+	InstanceName string `json:"instanceName,omitempty"`
+
+	// InstanceRef references the PostgreSQL instance to retrieve its name.
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects the PostgreSQL instance to retrieve its name.
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=128
+
+	// LCCollate is the default string sort order (LC_COLLATE) of the database.
+	// Cannot be changed after the database is created.
+	LCCollate string `json:"lcCollate,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=128
+
+	// LCCtype is the default character classification (LC_CTYPE) of the database.
+	// Cannot be changed after the database is created.
+	LCCtype string `json:"lcCtype,omitempty"`
+
+	// +kubebuilder:default=true
+
+	// DeletionProtection prevents the database from being dropped.
+	// Deleting the resource is rejected while it's enabled, unless the deletion policy is `Orphan`
+	// or the annotation `exoscale.crossplane.io/allow-deletion` is set to "true".
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+}
+
+// PostgreSQLDatabaseSpec defines the desired state of a PostgreSQLDatabase.
+type PostgreSQLDatabaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PostgreSQLDatabaseParameters `json:"forProvider"`
+}
+
+// PostgreSQLDatabaseObservation are the observable fields of a PostgreSQLDatabase.
+type PostgreSQLDatabaseObservation struct {
+	// DatabaseName is the observed name of the database.
+	DatabaseName string `json:"databaseName,omitempty"`
+}
+
+// PostgreSQLDatabaseStatus represents the observed state of a PostgreSQLDatabase.
+type PostgreSQLDatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PostgreSQLDatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Instance",type="string",JSONPath=".spec.forProvider.instanceName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-exoscale-crossplane-io-v1-postgresqldatabase,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=postgresqldatabases,versions=v1,name=postgresqldatabases.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// PostgreSQLDatabase is the API for creating logical databases on a PostgreSQL instance.
+type PostgreSQLDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgreSQLDatabaseSpec   `json:"spec"`
+	Status PostgreSQLDatabaseStatus `json:"status,omitempty"`
+}
+
+// GetProviderConfigName returns the name of the ProviderConfig.
+// Returns empty string if reference not given.
+func (in *PostgreSQLDatabase) GetProviderConfigName() string {
+	if ref := in.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// GetDatabaseName returns the name of the database in the following precedence:
+//
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *PostgreSQLDatabase) GetDatabaseName() string {
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLDatabaseList contains a list of PostgreSQLDatabase
+type PostgreSQLDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLDatabase `json:"items"`
+}
+
+// PostgreSQLDatabase type metadata.
+var (
+	PostgreSQLDatabaseKind             = reflect.TypeOf(PostgreSQLDatabase{}).Name()
+	PostgreSQLDatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLDatabaseKind}.String()
+	PostgreSQLDatabaseKindAPIVersion   = PostgreSQLDatabaseKind + "." + SchemeGroupVersion.String()
+	PostgreSQLDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLDatabaseKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PostgreSQLDatabase{}, &PostgreSQLDatabaseList{})
+}
@@ -0,0 +1,120 @@
+package v1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PostgreSQLUserParameters are the configurable fields of a PostgreSQLUser.
+type PostgreSQLUserParameters struct {
+	// +kubebuilder:validation:Required
+
+	// Zone is the datacenter identifier in which the PostgreSQL instance runs in.
+	Zone Zone `json:"zone"`
+
+	// InstanceName is the name of the PostgreSQL instance the user belongs to.
+	// Cannot be changed after the user is created.
+	// +crossplane:generate:reference:type=PostgreSQL
+	// +crossplane:generate:reference:refFieldName=InstanceRef
+	// +crossplane:generate:reference:selectorFieldName=InstanceSelector
+	InstanceName string `json:"instanceName,omitempty"`
+
+	// InstanceRef references the PostgreSQL instance to retrieve its name.
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects the PostgreSQL instance to retrieve its name.
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// AllowReplication allows the user to open replication connections.
+	AllowReplication bool `json:"allowReplication,omitempty"`
+
+	// PasswordSecretRef references the key of a Secret containing the password of the user.
+	// If not set, a password is generated by Exoscale.
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+}
+
+// PostgreSQLUserSpec defines the desired state of a PostgreSQLUser.
+type PostgreSQLUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PostgreSQLUserParameters `json:"forProvider"`
+}
+
+// PostgreSQLUserObservation are the observable fields of a PostgreSQLUser.
+type PostgreSQLUserObservation struct {
+	// Username is the observed name of the user.
+	Username string `json:"username,omitempty"`
+	// Type of the user account.
+	Type string `json:"type,omitempty"`
+	// AllowReplication is true if the user may open replication connections.
+	AllowReplication bool `json:"allowReplication,omitempty"`
+}
+
+// PostgreSQLUserStatus represents the observed state of a PostgreSQLUser.
+type PostgreSQLUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PostgreSQLUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="External Name",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="Instance",type="string",JSONPath=".spec.forProvider.instanceName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
+// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-postgresqluser,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=postgresqlusers,versions=v1,name=postgresqlusers.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// PostgreSQLUser is the API for creating users on a PostgreSQL instance.
+type PostgreSQLUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgreSQLUserSpec   `json:"spec"`
+	Status PostgreSQLUserStatus `json:"status,omitempty"`
+}
+
+// GetProviderConfigName returns the name of the ProviderConfig.
+// Returns empty string if reference not given.
+func (in *PostgreSQLUser) GetProviderConfigName() string {
+	if ref := in.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// GetUsername returns the name of the user in the following precedence:
+//
+//	.metadata.annotations."crossplane.io/external-name"
+//	.metadata.name
+func (in *PostgreSQLUser) GetUsername() string {
+	if name := meta.GetExternalName(in); name != "" {
+		return name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLUserList contains a list of PostgreSQLUser
+type PostgreSQLUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLUser `json:"items"`
+}
+
+// PostgreSQLUser type metadata.
+var (
+	PostgreSQLUserKind             = reflect.TypeOf(PostgreSQLUser{}).Name()
+	PostgreSQLUserGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLUserKind}.String()
+	PostgreSQLUserKindAPIVersion   = PostgreSQLUserKind + "." + SchemeGroupVersion.String()
+	PostgreSQLUserGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PostgreSQLUser{}, &PostgreSQLUserList{})
+}
@@ -23,6 +23,20 @@ type RedisParameters struct {
 
 	// RedisSettings contains additional Redis settings.
 	RedisSettings runtime.RawExtension `json:"redisSettings,omitempty"`
+
+	// +kubebuilder:validation:Enum=Full;ManagedKeysOnly
+	// +kubebuilder:default="Full"
+
+	// SettingsPolicy determines which settings are compared with the observed settings.
+	SettingsPolicy SettingsPolicy `json:"settingsPolicy,omitempty"`
+
+	// ExternalEndpoints are the DBaaSExternalEndpoints the instance sends metrics or logs to.
+	// Attachments are not managed if not set.
+	ExternalEndpoints []ExternalEndpointAttachment `json:"externalEndpoints,omitempty"`
+
+	// MaxBackupAge is the maximum age of the most recent backup before the BackupUpToDate condition becomes False.
+	// The condition isn't reported if not set.
+	MaxBackupAge *metav1.Duration `json:"maxBackupAge,omitempty"`
 }
 
 // RedisSpec defines the desired state of a Redis.
@@ -42,6 +56,17 @@ type RedisObservation struct {
 
 	// Service notifications
 	Notifications []Notification `json:"notifications,omitempty"`
+
+	// ExternalEndpoints are the external endpoints the instance is attached to.
+	ExternalEndpoints []ExternalEndpointObservation `json:"externalEndpoints,omitempty"`
+
+	// Triggers are the outcomes of the operations triggered by annotation.
+	Triggers []TriggerObservation `json:"triggers,omitempty"`
+
+	// Backups are the backups of the instance.
+	Backups []Backup `json:"backups,omitempty"`
+	// LastBackupTime is the time of the most recent backup.
+	LastBackupTime *metav1.Time `json:"lastBackupTime,omitempty"`
 }
 
 // RedisStatus represents the observed state of a Redis instance.
@@ -59,7 +84,7 @@ type RedisStatus struct {
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,exoscale}
-// +kubebuilder:webhook:verbs=create;update,path=/validate-exoscale-crossplane-io-v1-redis,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=redis,versions=v1,name=redis.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-exoscale-crossplane-io-v1-redis,mutating=false,failurePolicy=fail,groups=exoscale.crossplane.io,resources=redis,versions=v1,name=redis.exoscale.crossplane.io,sideEffects=None,admissionReviewVersions=v1
 
 // Redis is the API for creating Redis.
 type Redis struct {
@@ -5,9 +5,27 @@
 package v1
 
 import (
+	commonv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Backup) DeepCopyInto(out *Backup) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backup.
+func (in *Backup) DeepCopy() *Backup {
+	if in == nil {
+		return nil
+	}
+	out := new(Backup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 	*out = *in
@@ -147,28 +165,7 @@ func (in *BucketStatus) DeepCopy() *BucketStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBaaSParameters) DeepCopyInto(out *DBaaSParameters) {
-	*out = *in
-	out.Size = in.Size
-	if in.IPFilter != nil {
-		in, out := &in.IPFilter, &out.IPFilter
-		*out = make(IPFilter, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSParameters.
-func (in *DBaaSParameters) DeepCopy() *DBaaSParameters {
-	if in == nil {
-		return nil
-	}
-	out := new(DBaaSParameters)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IAMKey) DeepCopyInto(out *IAMKey) {
+func (in *DBaaSExternalEndpoint) DeepCopyInto(out *DBaaSExternalEndpoint) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -176,18 +173,18 @@ func (in *IAMKey) DeepCopyInto(out *IAMKey) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMKey.
-func (in *IAMKey) DeepCopy() *IAMKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSExternalEndpoint.
+func (in *DBaaSExternalEndpoint) DeepCopy() *DBaaSExternalEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(IAMKey)
+	out := new(DBaaSExternalEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IAMKey) DeepCopyObject() runtime.Object {
+func (in *DBaaSExternalEndpoint) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -195,31 +192,31 @@ func (in *IAMKey) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IAMKeyList) DeepCopyInto(out *IAMKeyList) {
+func (in *DBaaSExternalEndpointList) DeepCopyInto(out *DBaaSExternalEndpointList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IAMKey, len(*in))
+		*out = make([]DBaaSExternalEndpoint, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMKeyList.
-func (in *IAMKeyList) DeepCopy() *IAMKeyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSExternalEndpointList.
+func (in *DBaaSExternalEndpointList) DeepCopy() *DBaaSExternalEndpointList {
 	if in == nil {
 		return nil
 	}
-	out := new(IAMKeyList)
+	out := new(DBaaSExternalEndpointList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IAMKeyList) DeepCopyObject() runtime.Object {
+func (in *DBaaSExternalEndpointList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -227,92 +224,96 @@ func (in *IAMKeyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IAMKeyObservation) DeepCopyInto(out *IAMKeyObservation) {
+func (in *DBaaSExternalEndpointObservation) DeepCopyInto(out *DBaaSExternalEndpointObservation) {
 	*out = *in
-	in.ServicesSpec.DeepCopyInto(&out.ServicesSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMKeyObservation.
-func (in *IAMKeyObservation) DeepCopy() *IAMKeyObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSExternalEndpointObservation.
+func (in *DBaaSExternalEndpointObservation) DeepCopy() *DBaaSExternalEndpointObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(IAMKeyObservation)
+	out := new(DBaaSExternalEndpointObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IAMKeyParameters) DeepCopyInto(out *IAMKeyParameters) {
+func (in *DBaaSExternalEndpointParameters) DeepCopyInto(out *DBaaSExternalEndpointParameters) {
 	*out = *in
-	in.Services.DeepCopyInto(&out.Services)
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(commonv1.SecretReference)
+		**out = **in
+	}
+	if in.Datadog != nil {
+		in, out := &in.Datadog, &out.Datadog
+		*out = new(DatadogEndpointSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Elasticsearch != nil {
+		in, out := &in.Elasticsearch, &out.Elasticsearch
+		*out = new(IndexEndpointSettings)
+		**out = **in
+	}
+	if in.OpenSearch != nil {
+		in, out := &in.OpenSearch, &out.OpenSearch
+		*out = new(IndexEndpointSettings)
+		**out = **in
+	}
+	if in.Rsyslog != nil {
+		in, out := &in.Rsyslog, &out.Rsyslog
+		*out = new(RsyslogEndpointSettings)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMKeyParameters.
-func (in *IAMKeyParameters) DeepCopy() *IAMKeyParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSExternalEndpointParameters.
+func (in *DBaaSExternalEndpointParameters) DeepCopy() *DBaaSExternalEndpointParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(IAMKeyParameters)
+	out := new(DBaaSExternalEndpointParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IAMKeySpec) DeepCopyInto(out *IAMKeySpec) {
+func (in *DBaaSExternalEndpointSpec) DeepCopyInto(out *DBaaSExternalEndpointSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMKeySpec.
-func (in *IAMKeySpec) DeepCopy() *IAMKeySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSExternalEndpointSpec.
+func (in *DBaaSExternalEndpointSpec) DeepCopy() *DBaaSExternalEndpointSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IAMKeySpec)
+	out := new(DBaaSExternalEndpointSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IAMKeyStatus) DeepCopyInto(out *IAMKeyStatus) {
+func (in *DBaaSExternalEndpointStatus) DeepCopyInto(out *DBaaSExternalEndpointStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMKeyStatus.
-func (in *IAMKeyStatus) DeepCopy() *IAMKeyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSExternalEndpointStatus.
+func (in *DBaaSExternalEndpointStatus) DeepCopy() *DBaaSExternalEndpointStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IAMKeyStatus)
+	out := new(DBaaSExternalEndpointStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in IPFilter) DeepCopyInto(out *IPFilter) {
-	{
-		in := &in
-		*out = make(IPFilter, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPFilter.
-func (in IPFilter) DeepCopy() IPFilter {
-	if in == nil {
-		return nil
-	}
-	out := new(IPFilter)
-	in.DeepCopyInto(out)
-	return *out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Kafka) DeepCopyInto(out *Kafka) {
+func (in *DBaaSIntegration) DeepCopyInto(out *DBaaSIntegration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -320,18 +321,18 @@ func (in *Kafka) DeepCopyInto(out *Kafka) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafka.
-func (in *Kafka) DeepCopy() *Kafka {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSIntegration.
+func (in *DBaaSIntegration) DeepCopy() *DBaaSIntegration {
 	if in == nil {
 		return nil
 	}
-	out := new(Kafka)
+	out := new(DBaaSIntegration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Kafka) DeepCopyObject() runtime.Object {
+func (in *DBaaSIntegration) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -339,31 +340,31 @@ func (in *Kafka) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaList) DeepCopyInto(out *KafkaList) {
+func (in *DBaaSIntegrationList) DeepCopyInto(out *DBaaSIntegrationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Kafka, len(*in))
+		*out = make([]DBaaSIntegration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaList.
-func (in *KafkaList) DeepCopy() *KafkaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSIntegrationList.
+func (in *DBaaSIntegrationList) DeepCopy() *DBaaSIntegrationList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaList)
+	out := new(DBaaSIntegrationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaList) DeepCopyObject() runtime.Object {
+func (in *DBaaSIntegrationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -371,104 +372,176 @@ func (in *KafkaList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaObservation) DeepCopyInto(out *KafkaObservation) {
+func (in *DBaaSIntegrationObservation) DeepCopyInto(out *DBaaSIntegrationObservation) {
 	*out = *in
-	in.KafkaSettings.DeepCopyInto(&out.KafkaSettings)
-	in.KafkaRestSettings.DeepCopyInto(&out.KafkaRestSettings)
-	if in.NodeStates != nil {
-		in, out := &in.NodeStates, &out.NodeStates
-		*out = make([]NodeState, len(*in))
-		copy(*out, *in)
-	}
-	if in.Notifications != nil {
-		in, out := &in.Notifications, &out.Notifications
-		*out = make([]Notification, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.Settings.DeepCopyInto(&out.Settings)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaObservation.
-func (in *KafkaObservation) DeepCopy() *KafkaObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSIntegrationObservation.
+func (in *DBaaSIntegrationObservation) DeepCopy() *DBaaSIntegrationObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaObservation)
+	out := new(DBaaSIntegrationObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaParameters) DeepCopyInto(out *KafkaParameters) {
+func (in *DBaaSIntegrationParameters) DeepCopyInto(out *DBaaSIntegrationParameters) {
 	*out = *in
-	out.Maintenance = in.Maintenance
-	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
-	in.KafkaSettings.DeepCopyInto(&out.KafkaSettings)
-	in.KafkaRestSettings.DeepCopyInto(&out.KafkaRestSettings)
+	out.Source = in.Source
+	out.Destination = in.Destination
+	in.Settings.DeepCopyInto(&out.Settings)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaParameters.
-func (in *KafkaParameters) DeepCopy() *KafkaParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSIntegrationParameters.
+func (in *DBaaSIntegrationParameters) DeepCopy() *DBaaSIntegrationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaParameters)
+	out := new(DBaaSIntegrationParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSpec) DeepCopyInto(out *KafkaSpec) {
+func (in *DBaaSIntegrationSpec) DeepCopyInto(out *DBaaSIntegrationSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSpec.
-func (in *KafkaSpec) DeepCopy() *KafkaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSIntegrationSpec.
+func (in *DBaaSIntegrationSpec) DeepCopy() *DBaaSIntegrationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSpec)
+	out := new(DBaaSIntegrationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaStatus) DeepCopyInto(out *KafkaStatus) {
+func (in *DBaaSIntegrationStatus) DeepCopyInto(out *DBaaSIntegrationStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaStatus.
-func (in *KafkaStatus) DeepCopy() *KafkaStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSIntegrationStatus.
+func (in *DBaaSIntegrationStatus) DeepCopy() *DBaaSIntegrationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaStatus)
+	out := new(DBaaSIntegrationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MaintenanceSpec) DeepCopyInto(out *MaintenanceSpec) {
+func (in *DBaaSParameters) DeepCopyInto(out *DBaaSParameters) {
 	*out = *in
+	out.Size = in.Size
+	if in.IPFilter != nil {
+		in, out := &in.IPFilter, &out.IPFilter
+		*out = make(IPFilter, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceSpec.
-func (in *MaintenanceSpec) DeepCopy() *MaintenanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSParameters.
+func (in *DBaaSParameters) DeepCopy() *DBaaSParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(MaintenanceSpec)
+	out := new(DBaaSParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQL) DeepCopyInto(out *MySQL) {
+func (in *DBaaSServiceReference) DeepCopyInto(out *DBaaSServiceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBaaSServiceReference.
+func (in *DBaaSServiceReference) DeepCopy() *DBaaSServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DBaaSServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogEndpointSettings) DeepCopyInto(out *DatadogEndpointSettings) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]DatadogTag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogEndpointSettings.
+func (in *DatadogEndpointSettings) DeepCopy() *DatadogEndpointSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogEndpointSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogTag) DeepCopyInto(out *DatadogTag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogTag.
+func (in *DatadogTag) DeepCopy() *DatadogTag {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalEndpointAttachment) DeepCopyInto(out *ExternalEndpointAttachment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEndpointAttachment.
+func (in *ExternalEndpointAttachment) DeepCopy() *ExternalEndpointAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalEndpointAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalEndpointObservation) DeepCopyInto(out *ExternalEndpointObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEndpointObservation.
+func (in *ExternalEndpointObservation) DeepCopy() *ExternalEndpointObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalEndpointObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Grafana) DeepCopyInto(out *Grafana) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -476,18 +549,18 @@ func (in *MySQL) DeepCopyInto(out *MySQL) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQL.
-func (in *MySQL) DeepCopy() *MySQL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grafana.
+func (in *Grafana) DeepCopy() *Grafana {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQL)
+	out := new(Grafana)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MySQL) DeepCopyObject() runtime.Object {
+func (in *Grafana) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -495,31 +568,31 @@ func (in *MySQL) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLList) DeepCopyInto(out *MySQLList) {
+func (in *GrafanaList) DeepCopyInto(out *GrafanaList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MySQL, len(*in))
+		*out = make([]Grafana, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLList.
-func (in *MySQLList) DeepCopy() *MySQLList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaList.
+func (in *GrafanaList) DeepCopy() *GrafanaList {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLList)
+	out := new(GrafanaList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MySQLList) DeepCopyObject() runtime.Object {
+func (in *GrafanaList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -527,17 +600,14 @@ func (in *MySQLList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLObservation) DeepCopyInto(out *MySQLObservation) {
+func (in *GrafanaObservation) DeepCopyInto(out *GrafanaObservation) {
 	*out = *in
-	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
-	out.Maintenance = in.Maintenance
-	out.Backup = in.Backup
+	in.GrafanaSettings.DeepCopyInto(&out.GrafanaSettings)
 	if in.NodeStates != nil {
 		in, out := &in.NodeStates, &out.NodeStates
 		*out = make([]NodeState, len(*in))
 		copy(*out, *in)
 	}
-	in.MySQLSettings.DeepCopyInto(&out.MySQLSettings)
 	if in.Notifications != nil {
 		in, out := &in.Notifications, &out.Notifications
 		*out = make([]Notification, len(*in))
@@ -545,104 +615,1821 @@ func (in *MySQLObservation) DeepCopyInto(out *MySQLObservation) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]TriggerObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLObservation.
-func (in *MySQLObservation) DeepCopy() *MySQLObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaObservation.
+func (in *GrafanaObservation) DeepCopy() *GrafanaObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLObservation)
+	out := new(GrafanaObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLParameters) DeepCopyInto(out *MySQLParameters) {
+func (in *GrafanaParameters) DeepCopyInto(out *GrafanaParameters) {
 	*out = *in
 	out.Maintenance = in.Maintenance
-	out.Backup = in.Backup
 	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
-	in.MySQLSettings.DeepCopyInto(&out.MySQLSettings)
+	in.GrafanaSettings.DeepCopyInto(&out.GrafanaSettings)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLParameters.
-func (in *MySQLParameters) DeepCopy() *MySQLParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaParameters.
+func (in *GrafanaParameters) DeepCopy() *GrafanaParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLParameters)
+	out := new(GrafanaParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLSpec) DeepCopyInto(out *MySQLSpec) {
+func (in *GrafanaSpec) DeepCopyInto(out *GrafanaSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSpec.
-func (in *MySQLSpec) DeepCopy() *MySQLSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaSpec.
+func (in *GrafanaSpec) DeepCopy() *GrafanaSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLSpec)
+	out := new(GrafanaSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLStatus) DeepCopyInto(out *MySQLStatus) {
+func (in *GrafanaStatus) DeepCopyInto(out *GrafanaStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLStatus.
-func (in *MySQLStatus) DeepCopy() *MySQLStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaStatus.
+func (in *GrafanaStatus) DeepCopy() *GrafanaStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLStatus)
+	out := new(GrafanaStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeState) DeepCopyInto(out *NodeState) {
+func (in *IAMKey) DeepCopyInto(out *IAMKey) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeState.
-func (in *NodeState) DeepCopy() *NodeState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMKey.
+func (in *IAMKey) DeepCopy() *IAMKey {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeState)
+	out := new(IAMKey)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Notification) DeepCopyInto(out *Notification) {
-	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IAMKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMKeyList) DeepCopyInto(out *IAMKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IAMKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMKeyList.
+func (in *IAMKeyList) DeepCopy() *IAMKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IAMKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMKeyObservation) DeepCopyInto(out *IAMKeyObservation) {
+	*out = *in
+	in.ServicesSpec.DeepCopyInto(&out.ServicesSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMKeyObservation.
+func (in *IAMKeyObservation) DeepCopy() *IAMKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMKeyParameters) DeepCopyInto(out *IAMKeyParameters) {
+	*out = *in
+	in.Services.DeepCopyInto(&out.Services)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMKeyParameters.
+func (in *IAMKeyParameters) DeepCopy() *IAMKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMKeySpec) DeepCopyInto(out *IAMKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMKeySpec.
+func (in *IAMKeySpec) DeepCopy() *IAMKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMKeyStatus) DeepCopyInto(out *IAMKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMKeyStatus.
+func (in *IAMKeyStatus) DeepCopy() *IAMKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in IPFilter) DeepCopyInto(out *IPFilter) {
+	{
+		in := &in
+		*out = make(IPFilter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPFilter.
+func (in IPFilter) DeepCopy() IPFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(IPFilter)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IndexEndpointSettings) DeepCopyInto(out *IndexEndpointSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexEndpointSettings.
+func (in *IndexEndpointSettings) DeepCopy() *IndexEndpointSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(IndexEndpointSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Kafka) DeepCopyInto(out *Kafka) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafka.
+func (in *Kafka) DeepCopy() *Kafka {
+	if in == nil {
+		return nil
+	}
+	out := new(Kafka)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Kafka) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACL) DeepCopyInto(out *KafkaACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACL.
+func (in *KafkaACL) DeepCopy() *KafkaACL {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLList) DeepCopyInto(out *KafkaACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLList.
+func (in *KafkaACLList) DeepCopy() *KafkaACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLObservation) DeepCopyInto(out *KafkaACLObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLObservation.
+func (in *KafkaACLObservation) DeepCopy() *KafkaACLObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLParameters) DeepCopyInto(out *KafkaACLParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserRef != nil {
+		in, out := &in.UserRef, &out.UserRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserSelector != nil {
+		in, out := &in.UserSelector, &out.UserSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLParameters.
+func (in *KafkaACLParameters) DeepCopy() *KafkaACLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLSpec) DeepCopyInto(out *KafkaACLSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLSpec.
+func (in *KafkaACLSpec) DeepCopy() *KafkaACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLStatus) DeepCopyInto(out *KafkaACLStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLStatus.
+func (in *KafkaACLStatus) DeepCopy() *KafkaACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaList) DeepCopyInto(out *KafkaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Kafka, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaList.
+func (in *KafkaList) DeepCopy() *KafkaList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaObservation) DeepCopyInto(out *KafkaObservation) {
+	*out = *in
+	in.KafkaSettings.DeepCopyInto(&out.KafkaSettings)
+	in.KafkaRestSettings.DeepCopyInto(&out.KafkaRestSettings)
+	in.KafkaConnectSettings.DeepCopyInto(&out.KafkaConnectSettings)
+	in.SchemaRegistrySettings.DeepCopyInto(&out.SchemaRegistrySettings)
+	if in.NodeStates != nil {
+		in, out := &in.NodeStates, &out.NodeStates
+		*out = make([]NodeState, len(*in))
+		copy(*out, *in)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]Notification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExternalEndpoints != nil {
+		in, out := &in.ExternalEndpoints, &out.ExternalEndpoints
+		*out = make([]ExternalEndpointObservation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]TriggerObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]Backup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaObservation.
+func (in *KafkaObservation) DeepCopy() *KafkaObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaParameters) DeepCopyInto(out *KafkaParameters) {
+	*out = *in
+	out.Maintenance = in.Maintenance
+	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
+	in.KafkaSettings.DeepCopyInto(&out.KafkaSettings)
+	in.KafkaRestSettings.DeepCopyInto(&out.KafkaRestSettings)
+	in.KafkaConnectSettings.DeepCopyInto(&out.KafkaConnectSettings)
+	in.SchemaRegistrySettings.DeepCopyInto(&out.SchemaRegistrySettings)
+	if in.ExternalEndpoints != nil {
+		in, out := &in.ExternalEndpoints, &out.ExternalEndpoints
+		*out = make([]ExternalEndpointAttachment, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxBackupAge != nil {
+		in, out := &in.MaxBackupAge, &out.MaxBackupAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaParameters.
+func (in *KafkaParameters) DeepCopy() *KafkaParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSpec) DeepCopyInto(out *KafkaSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSpec.
+func (in *KafkaSpec) DeepCopy() *KafkaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaStatus) DeepCopyInto(out *KafkaStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaStatus.
+func (in *KafkaStatus) DeepCopy() *KafkaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUser) DeepCopyInto(out *KafkaUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUser.
+func (in *KafkaUser) DeepCopy() *KafkaUser {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserList) DeepCopyInto(out *KafkaUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserList.
+func (in *KafkaUserList) DeepCopy() *KafkaUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserObservation) DeepCopyInto(out *KafkaUserObservation) {
+	*out = *in
+	if in.AccessCertExpiry != nil {
+		in, out := &in.AccessCertExpiry, &out.AccessCertExpiry
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserObservation.
+func (in *KafkaUserObservation) DeepCopy() *KafkaUserObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserParameters) DeepCopyInto(out *KafkaUserParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserParameters.
+func (in *KafkaUserParameters) DeepCopy() *KafkaUserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserSpec) DeepCopyInto(out *KafkaUserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserSpec.
+func (in *KafkaUserSpec) DeepCopy() *KafkaUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserStatus) DeepCopyInto(out *KafkaUserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserStatus.
+func (in *KafkaUserStatus) DeepCopy() *KafkaUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceSpec) DeepCopyInto(out *MaintenanceSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceSpec.
+func (in *MaintenanceSpec) DeepCopy() *MaintenanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationDetail) DeepCopyInto(out *MigrationDetail) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationDetail.
+func (in *MigrationDetail) DeepCopy() *MigrationDetail {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationDetail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationObservation) DeepCopyInto(out *MigrationObservation) {
+	*out = *in
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = new(MigrationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Details != nil {
+		in, out := &in.Details, &out.Details
+		*out = make([]MigrationDetail, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationObservation.
+func (in *MigrationObservation) DeepCopy() *MigrationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationSpec) DeepCopyInto(out *MigrationSpec) {
+	*out = *in
+	if in.IgnoreDBs != nil {
+		in, out := &in.IgnoreDBs, &out.IgnoreDBs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SSL != nil {
+		in, out := &in.SSL, &out.SSL
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(commonv1.SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationSpec.
+func (in *MigrationSpec) DeepCopy() *MigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQL) DeepCopyInto(out *MySQL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQL.
+func (in *MySQL) DeepCopy() *MySQL {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLDatabase) DeepCopyInto(out *MySQLDatabase) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLDatabase.
+func (in *MySQLDatabase) DeepCopy() *MySQLDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLDatabase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLDatabaseList) DeepCopyInto(out *MySQLDatabaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQLDatabase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLDatabaseList.
+func (in *MySQLDatabaseList) DeepCopy() *MySQLDatabaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLDatabaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLDatabaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLDatabaseObservation) DeepCopyInto(out *MySQLDatabaseObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLDatabaseObservation.
+func (in *MySQLDatabaseObservation) DeepCopy() *MySQLDatabaseObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLDatabaseObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLDatabaseParameters) DeepCopyInto(out *MySQLDatabaseParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLDatabaseParameters.
+func (in *MySQLDatabaseParameters) DeepCopy() *MySQLDatabaseParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLDatabaseParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLDatabaseSpec) DeepCopyInto(out *MySQLDatabaseSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLDatabaseSpec.
+func (in *MySQLDatabaseSpec) DeepCopy() *MySQLDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLDatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLDatabaseStatus) DeepCopyInto(out *MySQLDatabaseStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLDatabaseStatus.
+func (in *MySQLDatabaseStatus) DeepCopy() *MySQLDatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLDatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLList) DeepCopyInto(out *MySQLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLList.
+func (in *MySQLList) DeepCopy() *MySQLList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLObservation) DeepCopyInto(out *MySQLObservation) {
+	*out = *in
+	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
+	out.Maintenance = in.Maintenance
+	out.Backup = in.Backup
+	if in.NodeStates != nil {
+		in, out := &in.NodeStates, &out.NodeStates
+		*out = make([]NodeState, len(*in))
+		copy(*out, *in)
+	}
+	in.MySQLSettings.DeepCopyInto(&out.MySQLSettings)
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]Notification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExternalEndpoints != nil {
+		in, out := &in.ExternalEndpoints, &out.ExternalEndpoints
+		*out = make([]ExternalEndpointObservation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]TriggerObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]Backup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(MigrationObservation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLObservation.
+func (in *MySQLObservation) DeepCopy() *MySQLObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLParameters) DeepCopyInto(out *MySQLParameters) {
+	*out = *in
+	out.Maintenance = in.Maintenance
+	out.Backup = in.Backup
+	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
+	in.MySQLSettings.DeepCopyInto(&out.MySQLSettings)
+	if in.ForkFromRef != nil {
+		in, out := &in.ForkFromRef, &out.ForkFromRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForkFromSelector != nil {
+		in, out := &in.ForkFromSelector, &out.ForkFromSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecoveryTargetTime != nil {
+		in, out := &in.RecoveryTargetTime, &out.RecoveryTargetTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExternalEndpoints != nil {
+		in, out := &in.ExternalEndpoints, &out.ExternalEndpoints
+		*out = make([]ExternalEndpointAttachment, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxBackupAge != nil {
+		in, out := &in.MaxBackupAge, &out.MaxBackupAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(MigrationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLParameters.
+func (in *MySQLParameters) DeepCopy() *MySQLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLSpec) DeepCopyInto(out *MySQLSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSpec.
+func (in *MySQLSpec) DeepCopy() *MySQLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLStatus) DeepCopyInto(out *MySQLStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLStatus.
+func (in *MySQLStatus) DeepCopy() *MySQLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLUser) DeepCopyInto(out *MySQLUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLUser.
+func (in *MySQLUser) DeepCopy() *MySQLUser {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLUserList) DeepCopyInto(out *MySQLUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQLUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLUserList.
+func (in *MySQLUserList) DeepCopy() *MySQLUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLUserObservation) DeepCopyInto(out *MySQLUserObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLUserObservation.
+func (in *MySQLUserObservation) DeepCopy() *MySQLUserObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLUserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLUserParameters) DeepCopyInto(out *MySQLUserParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLUserParameters.
+func (in *MySQLUserParameters) DeepCopy() *MySQLUserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLUserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLUserSpec) DeepCopyInto(out *MySQLUserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLUserSpec.
+func (in *MySQLUserSpec) DeepCopy() *MySQLUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLUserStatus) DeepCopyInto(out *MySQLUserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLUserStatus.
+func (in *MySQLUserStatus) DeepCopy() *MySQLUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeState) DeepCopyInto(out *NodeState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeState.
+func (in *NodeState) DeepCopy() *NodeState {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notification) DeepCopyInto(out *Notification) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notification.
+func (in *Notification) DeepCopy() *Notification {
+	if in == nil {
+		return nil
+	}
+	out := new(Notification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearch) DeepCopyInto(out *OpenSearch) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearch.
+func (in *OpenSearch) DeepCopy() *OpenSearch {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearch) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchACLRule) DeepCopyInto(out *OpenSearchACLRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchACLRule.
+func (in *OpenSearchACLRule) DeepCopy() *OpenSearchACLRule {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchACLRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchList) DeepCopyInto(out *OpenSearchList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchList.
+func (in *OpenSearchList) DeepCopy() *OpenSearchList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchObservation) DeepCopyInto(out *OpenSearchObservation) {
+	*out = *in
+	in.OpenSearchSettings.DeepCopyInto(&out.OpenSearchSettings)
+	if in.NodeStates != nil {
+		in, out := &in.NodeStates, &out.NodeStates
+		*out = make([]NodeState, len(*in))
+		copy(*out, *in)
+	}
+	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]Notification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Maintenance = in.Maintenance
+	if in.ExternalEndpoints != nil {
+		in, out := &in.ExternalEndpoints, &out.ExternalEndpoints
+		*out = make([]ExternalEndpointObservation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]TriggerObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]Backup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchObservation.
+func (in *OpenSearchObservation) DeepCopy() *OpenSearchObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchParameters) DeepCopyInto(out *OpenSearchParameters) {
+	*out = *in
+	out.Maintenance = in.Maintenance
+	out.Backup = in.Backup
+	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
+	in.OpenSearchSettings.DeepCopyInto(&out.OpenSearchSettings)
+	if in.ACLEnabled != nil {
+		in, out := &in.ACLEnabled, &out.ACLEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtendedACLEnabled != nil {
+		in, out := &in.ExtendedACLEnabled, &out.ExtendedACLEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExternalEndpoints != nil {
+		in, out := &in.ExternalEndpoints, &out.ExternalEndpoints
+		*out = make([]ExternalEndpointAttachment, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxBackupAge != nil {
+		in, out := &in.MaxBackupAge, &out.MaxBackupAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchParameters.
+func (in *OpenSearchParameters) DeepCopy() *OpenSearchParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSpec) DeepCopyInto(out *OpenSearchSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSpec.
+func (in *OpenSearchSpec) DeepCopy() *OpenSearchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchStatus) DeepCopyInto(out *OpenSearchStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchStatus.
+func (in *OpenSearchStatus) DeepCopy() *OpenSearchStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchUser) DeepCopyInto(out *OpenSearchUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchUser.
+func (in *OpenSearchUser) DeepCopy() *OpenSearchUser {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchUserList) DeepCopyInto(out *OpenSearchUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearchUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchUserList.
+func (in *OpenSearchUserList) DeepCopy() *OpenSearchUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchUserObservation) DeepCopyInto(out *OpenSearchUserObservation) {
+	*out = *in
+	if in.ACLRules != nil {
+		in, out := &in.ACLRules, &out.ACLRules
+		*out = make([]OpenSearchACLRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchUserObservation.
+func (in *OpenSearchUserObservation) DeepCopy() *OpenSearchUserObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchUserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchUserParameters) DeepCopyInto(out *OpenSearchUserParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+	if in.ACLRules != nil {
+		in, out := &in.ACLRules, &out.ACLRules
+		*out = make([]OpenSearchACLRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchUserParameters.
+func (in *OpenSearchUserParameters) DeepCopy() *OpenSearchUserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchUserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchUserSpec) DeepCopyInto(out *OpenSearchUserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchUserSpec.
+func (in *OpenSearchUserSpec) DeepCopy() *OpenSearchUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchUserStatus) DeepCopyInto(out *OpenSearchUserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchUserStatus.
+func (in *OpenSearchUserStatus) DeepCopy() *OpenSearchUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQL) DeepCopyInto(out *PostgreSQL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQL.
+func (in *PostgreSQL) DeepCopy() *PostgreSQL {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLConnectionPool) DeepCopyInto(out *PostgreSQLConnectionPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLConnectionPool.
+func (in *PostgreSQLConnectionPool) DeepCopy() *PostgreSQLConnectionPool {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLConnectionPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQLConnectionPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLConnectionPoolList) DeepCopyInto(out *PostgreSQLConnectionPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgreSQLConnectionPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLConnectionPoolList.
+func (in *PostgreSQLConnectionPoolList) DeepCopy() *PostgreSQLConnectionPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLConnectionPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQLConnectionPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLConnectionPoolObservation) DeepCopyInto(out *PostgreSQLConnectionPoolObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLConnectionPoolObservation.
+func (in *PostgreSQLConnectionPoolObservation) DeepCopy() *PostgreSQLConnectionPoolObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLConnectionPoolObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLConnectionPoolParameters) DeepCopyInto(out *PostgreSQLConnectionPoolParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserRef != nil {
+		in, out := &in.UserRef, &out.UserRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserSelector != nil {
+		in, out := &in.UserSelector, &out.UserSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLConnectionPoolParameters.
+func (in *PostgreSQLConnectionPoolParameters) DeepCopy() *PostgreSQLConnectionPoolParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLConnectionPoolParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLConnectionPoolSpec) DeepCopyInto(out *PostgreSQLConnectionPoolSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLConnectionPoolSpec.
+func (in *PostgreSQLConnectionPoolSpec) DeepCopy() *PostgreSQLConnectionPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLConnectionPoolSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notification.
-func (in *Notification) DeepCopy() *Notification {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLConnectionPoolStatus) DeepCopyInto(out *PostgreSQLConnectionPoolStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLConnectionPoolStatus.
+func (in *PostgreSQLConnectionPoolStatus) DeepCopy() *PostgreSQLConnectionPoolStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Notification)
+	out := new(PostgreSQLConnectionPoolStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearch) DeepCopyInto(out *OpenSearch) {
+func (in *PostgreSQLDatabase) DeepCopyInto(out *PostgreSQLDatabase) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -650,18 +2437,18 @@ func (in *OpenSearch) DeepCopyInto(out *OpenSearch) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearch.
-func (in *OpenSearch) DeepCopy() *OpenSearch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLDatabase.
+func (in *PostgreSQLDatabase) DeepCopy() *PostgreSQLDatabase {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearch)
+	out := new(PostgreSQLDatabase)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenSearch) DeepCopyObject() runtime.Object {
+func (in *PostgreSQLDatabase) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -669,31 +2456,31 @@ func (in *OpenSearch) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchList) DeepCopyInto(out *OpenSearchList) {
+func (in *PostgreSQLDatabaseList) DeepCopyInto(out *PostgreSQLDatabaseList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]OpenSearch, len(*in))
+		*out = make([]PostgreSQLDatabase, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchList.
-func (in *OpenSearchList) DeepCopy() *OpenSearchList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLDatabaseList.
+func (in *PostgreSQLDatabaseList) DeepCopy() *PostgreSQLDatabaseList {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchList)
+	out := new(PostgreSQLDatabaseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenSearchList) DeepCopyObject() runtime.Object {
+func (in *PostgreSQLDatabaseList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -701,15 +2488,136 @@ func (in *OpenSearchList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchObservation) DeepCopyInto(out *OpenSearchObservation) {
+func (in *PostgreSQLDatabaseObservation) DeepCopyInto(out *PostgreSQLDatabaseObservation) {
 	*out = *in
-	in.OpenSearchSettings.DeepCopyInto(&out.OpenSearchSettings)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLDatabaseObservation.
+func (in *PostgreSQLDatabaseObservation) DeepCopy() *PostgreSQLDatabaseObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLDatabaseObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLDatabaseParameters) DeepCopyInto(out *PostgreSQLDatabaseParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLDatabaseParameters.
+func (in *PostgreSQLDatabaseParameters) DeepCopy() *PostgreSQLDatabaseParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLDatabaseParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLDatabaseSpec) DeepCopyInto(out *PostgreSQLDatabaseSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLDatabaseSpec.
+func (in *PostgreSQLDatabaseSpec) DeepCopy() *PostgreSQLDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLDatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLDatabaseStatus) DeepCopyInto(out *PostgreSQLDatabaseStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLDatabaseStatus.
+func (in *PostgreSQLDatabaseStatus) DeepCopy() *PostgreSQLDatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLDatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLList) DeepCopyInto(out *PostgreSQLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgreSQL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLList.
+func (in *PostgreSQLList) DeepCopy() *PostgreSQLList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLObservation) DeepCopyInto(out *PostgreSQLObservation) {
+	*out = *in
+	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
+	out.Maintenance = in.Maintenance
+	out.Backup = in.Backup
 	if in.NodeStates != nil {
 		in, out := &in.NodeStates, &out.NodeStates
 		*out = make([]NodeState, len(*in))
 		copy(*out, *in)
 	}
-	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
+	in.PGSettings.DeepCopyInto(&out.PGSettings)
+	in.PgBouncerSettings.DeepCopyInto(&out.PgBouncerSettings)
+	in.PGLookoutSettings.DeepCopyInto(&out.PGLookoutSettings)
+	in.TimescaleDBSettings.DeepCopyInto(&out.TimescaleDBSettings)
+	if in.ExternalEndpoints != nil {
+		in, out := &in.ExternalEndpoints, &out.ExternalEndpoints
+		*out = make([]ExternalEndpointObservation, len(*in))
+		copy(*out, *in)
+	}
 	if in.Notifications != nil {
 		in, out := &in.Notifications, &out.Notifications
 		*out = make([]Notification, len(*in))
@@ -717,74 +2625,148 @@ func (in *OpenSearchObservation) DeepCopyInto(out *OpenSearchObservation) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.Maintenance = in.Maintenance
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]TriggerObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]Backup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(MigrationObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(PostgreSQLUpgradeStatus)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchObservation.
-func (in *OpenSearchObservation) DeepCopy() *OpenSearchObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLObservation.
+func (in *PostgreSQLObservation) DeepCopy() *PostgreSQLObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchObservation)
+	out := new(PostgreSQLObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchParameters) DeepCopyInto(out *OpenSearchParameters) {
+func (in *PostgreSQLParameters) DeepCopyInto(out *PostgreSQLParameters) {
 	*out = *in
 	out.Maintenance = in.Maintenance
 	out.Backup = in.Backup
 	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
-	in.OpenSearchSettings.DeepCopyInto(&out.OpenSearchSettings)
+	in.PGSettings.DeepCopyInto(&out.PGSettings)
+	in.PgBouncerSettings.DeepCopyInto(&out.PgBouncerSettings)
+	in.PGLookoutSettings.DeepCopyInto(&out.PGLookoutSettings)
+	in.TimescaleDBSettings.DeepCopyInto(&out.TimescaleDBSettings)
+	if in.ForkFromRef != nil {
+		in, out := &in.ForkFromRef, &out.ForkFromRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForkFromSelector != nil {
+		in, out := &in.ForkFromSelector, &out.ForkFromSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecoveryTargetTime != nil {
+		in, out := &in.RecoveryTargetTime, &out.RecoveryTargetTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExternalEndpoints != nil {
+		in, out := &in.ExternalEndpoints, &out.ExternalEndpoints
+		*out = make([]ExternalEndpointAttachment, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxBackupAge != nil {
+		in, out := &in.MaxBackupAge, &out.MaxBackupAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(MigrationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchParameters.
-func (in *OpenSearchParameters) DeepCopy() *OpenSearchParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLParameters.
+func (in *PostgreSQLParameters) DeepCopy() *PostgreSQLParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchParameters)
+	out := new(PostgreSQLParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchSpec) DeepCopyInto(out *OpenSearchSpec) {
+func (in *PostgreSQLSpec) DeepCopyInto(out *PostgreSQLSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSpec.
-func (in *OpenSearchSpec) DeepCopy() *OpenSearchSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLSpec.
+func (in *PostgreSQLSpec) DeepCopy() *PostgreSQLSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchSpec)
+	out := new(PostgreSQLSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenSearchStatus) DeepCopyInto(out *OpenSearchStatus) {
+func (in *PostgreSQLStatus) DeepCopyInto(out *PostgreSQLStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchStatus.
-func (in *OpenSearchStatus) DeepCopy() *OpenSearchStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLStatus.
+func (in *PostgreSQLStatus) DeepCopy() *PostgreSQLStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenSearchStatus)
+	out := new(PostgreSQLStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQL) DeepCopyInto(out *PostgreSQL) {
+func (in *PostgreSQLUpgradeStatus) DeepCopyInto(out *PostgreSQLUpgradeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLUpgradeStatus.
+func (in *PostgreSQLUpgradeStatus) DeepCopy() *PostgreSQLUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLUser) DeepCopyInto(out *PostgreSQLUser) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -792,18 +2774,18 @@ func (in *PostgreSQL) DeepCopyInto(out *PostgreSQL) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQL.
-func (in *PostgreSQL) DeepCopy() *PostgreSQL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLUser.
+func (in *PostgreSQLUser) DeepCopy() *PostgreSQLUser {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQL)
+	out := new(PostgreSQLUser)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgreSQL) DeepCopyObject() runtime.Object {
+func (in *PostgreSQLUser) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -811,31 +2793,31 @@ func (in *PostgreSQL) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQLList) DeepCopyInto(out *PostgreSQLList) {
+func (in *PostgreSQLUserList) DeepCopyInto(out *PostgreSQLUserList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PostgreSQL, len(*in))
+		*out = make([]PostgreSQLUser, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLList.
-func (in *PostgreSQLList) DeepCopy() *PostgreSQLList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLUserList.
+func (in *PostgreSQLUserList) DeepCopy() *PostgreSQLUserList {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQLList)
+	out := new(PostgreSQLUserList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgreSQLList) DeepCopyObject() runtime.Object {
+func (in *PostgreSQLUserList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -843,78 +2825,80 @@ func (in *PostgreSQLList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQLObservation) DeepCopyInto(out *PostgreSQLObservation) {
+func (in *PostgreSQLUserObservation) DeepCopyInto(out *PostgreSQLUserObservation) {
 	*out = *in
-	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
-	out.Maintenance = in.Maintenance
-	out.Backup = in.Backup
-	if in.NodeStates != nil {
-		in, out := &in.NodeStates, &out.NodeStates
-		*out = make([]NodeState, len(*in))
-		copy(*out, *in)
-	}
-	in.PGSettings.DeepCopyInto(&out.PGSettings)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLObservation.
-func (in *PostgreSQLObservation) DeepCopy() *PostgreSQLObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLUserObservation.
+func (in *PostgreSQLUserObservation) DeepCopy() *PostgreSQLUserObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQLObservation)
+	out := new(PostgreSQLUserObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQLParameters) DeepCopyInto(out *PostgreSQLParameters) {
+func (in *PostgreSQLUserParameters) DeepCopyInto(out *PostgreSQLUserParameters) {
 	*out = *in
-	out.Maintenance = in.Maintenance
-	out.Backup = in.Backup
-	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
-	in.PGSettings.DeepCopyInto(&out.PGSettings)
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLParameters.
-func (in *PostgreSQLParameters) DeepCopy() *PostgreSQLParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLUserParameters.
+func (in *PostgreSQLUserParameters) DeepCopy() *PostgreSQLUserParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQLParameters)
+	out := new(PostgreSQLUserParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQLSpec) DeepCopyInto(out *PostgreSQLSpec) {
+func (in *PostgreSQLUserSpec) DeepCopyInto(out *PostgreSQLUserSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLSpec.
-func (in *PostgreSQLSpec) DeepCopy() *PostgreSQLSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLUserSpec.
+func (in *PostgreSQLUserSpec) DeepCopy() *PostgreSQLUserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQLSpec)
+	out := new(PostgreSQLUserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQLStatus) DeepCopyInto(out *PostgreSQLStatus) {
+func (in *PostgreSQLUserStatus) DeepCopyInto(out *PostgreSQLUserStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLStatus.
-func (in *PostgreSQLStatus) DeepCopy() *PostgreSQLStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLUserStatus.
+func (in *PostgreSQLUserStatus) DeepCopy() *PostgreSQLUserStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQLStatus)
+	out := new(PostgreSQLUserStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -994,6 +2978,29 @@ func (in *RedisObservation) DeepCopyInto(out *RedisObservation) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExternalEndpoints != nil {
+		in, out := &in.ExternalEndpoints, &out.ExternalEndpoints
+		*out = make([]ExternalEndpointObservation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]TriggerObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]Backup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastBackupTime != nil {
+		in, out := &in.LastBackupTime, &out.LastBackupTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisObservation.
@@ -1012,6 +3019,16 @@ func (in *RedisParameters) DeepCopyInto(out *RedisParameters) {
 	out.Maintenance = in.Maintenance
 	in.DBaaSParameters.DeepCopyInto(&out.DBaaSParameters)
 	in.RedisSettings.DeepCopyInto(&out.RedisSettings)
+	if in.ExternalEndpoints != nil {
+		in, out := &in.ExternalEndpoints, &out.ExternalEndpoints
+		*out = make([]ExternalEndpointAttachment, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxBackupAge != nil {
+		in, out := &in.MaxBackupAge, &out.MaxBackupAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisParameters.
@@ -1058,6 +3075,21 @@ func (in *RedisStatus) DeepCopy() *RedisStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RsyslogEndpointSettings) DeepCopyInto(out *RsyslogEndpointSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RsyslogEndpointSettings.
+func (in *RsyslogEndpointSettings) DeepCopy() *RsyslogEndpointSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RsyslogEndpointSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SOSSpec) DeepCopyInto(out *SOSSpec) {
 	*out = *in
@@ -1108,3 +3140,19 @@ func (in *SizeSpec) DeepCopy() *SizeSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerObservation) DeepCopyInto(out *TriggerObservation) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerObservation.
+func (in *TriggerObservation) DeepCopy() *TriggerObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -13,6 +13,33 @@ func (l *BucketList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this DBaaSExternalEndpointList.
+func (l *DBaaSExternalEndpointList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this DBaaSIntegrationList.
+func (l *DBaaSIntegrationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this GrafanaList.
+func (l *GrafanaList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this IAMKeyList.
 func (l *IAMKeyList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -22,6 +49,15 @@ func (l *IAMKeyList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this KafkaACLList.
+func (l *KafkaACLList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this KafkaList.
 func (l *KafkaList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -31,6 +67,24 @@ func (l *KafkaList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this KafkaUserList.
+func (l *KafkaUserList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this MySQLDatabaseList.
+func (l *MySQLDatabaseList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this MySQLList.
 func (l *MySQLList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -40,6 +94,15 @@ func (l *MySQLList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this MySQLUserList.
+func (l *MySQLUserList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this OpenSearchList.
 func (l *OpenSearchList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -49,6 +112,33 @@ func (l *OpenSearchList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this OpenSearchUserList.
+func (l *OpenSearchUserList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this PostgreSQLConnectionPoolList.
+func (l *PostgreSQLConnectionPoolList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this PostgreSQLDatabaseList.
+func (l *PostgreSQLDatabaseList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this PostgreSQLList.
 func (l *PostgreSQLList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -58,6 +148,15 @@ func (l *PostgreSQLList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this PostgreSQLUserList.
+func (l *PostgreSQLUserList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this RedisList.
 func (l *RedisList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
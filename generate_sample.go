@@ -40,10 +40,21 @@ func main() {
 	generateProviderConfigSample()
 	generateIAMKeyAdmissionRequest()
 	generateMysqlSample()
+	generateMysqlUserSample()
+	generateMysqlDatabaseSample()
 	generatePostgresqlSample()
+	generatePostgresqlUserSample()
+	generatePostgresqlDatabaseSample()
+	generatePostgresqlConnectionPoolSample()
 	generateRedisSample()
+	generateGrafanaSample()
+	generateDBaaSIntegrationSample()
+	generateDBaaSExternalEndpointSample()
 	generateKafkaSample()
+	generateKafkaUserSample()
+	generateKafkaACLSample()
 	generateOpensearchSample()
+	generateOpenSearchUserSample()
 }
 
 func generatePostgresqlSample() {
@@ -85,6 +96,138 @@ func newPostgresqlSample() *exoscalev1.PostgreSQL {
 	}
 }
 
+func generatePostgresqlUserSample() {
+	spec := newPostgresqlUserSample()
+	serialize(spec, true)
+}
+
+func newPostgresqlUserSample() *exoscalev1.PostgreSQLUser {
+	return &exoscalev1.PostgreSQLUser{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: exoscalev1.PostgreSQLUserGroupVersionKind.GroupVersion().String(),
+			Kind:       exoscalev1.PostgreSQLUserKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "postgresql-user-local-dev"},
+		Spec: exoscalev1.PostgreSQLUserSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference:          &xpv1.Reference{Name: "provider-config"},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: "postgresql-user-local-dev-details", Namespace: "default"},
+			},
+			ForProvider: exoscalev1.PostgreSQLUserParameters{
+				Zone:        "ch-dk-2",
+				InstanceRef: &xpv1.Reference{Name: "postgresql-local-dev"},
+			},
+		},
+	}
+}
+
+func generatePostgresqlDatabaseSample() {
+	spec := newPostgresqlDatabaseSample()
+	serialize(spec, true)
+}
+
+func newPostgresqlDatabaseSample() *exoscalev1.PostgreSQLDatabase {
+	return &exoscalev1.PostgreSQLDatabase{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: exoscalev1.PostgreSQLDatabaseGroupVersionKind.GroupVersion().String(),
+			Kind:       exoscalev1.PostgreSQLDatabaseKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "postgresql-database-local-dev"},
+		Spec: exoscalev1.PostgreSQLDatabaseSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: exoscalev1.PostgreSQLDatabaseParameters{
+				Zone:               "ch-dk-2",
+				InstanceRef:        &xpv1.Reference{Name: "postgresql-local-dev"},
+				LCCollate:          "en_US.UTF-8",
+				LCCtype:            "en_US.UTF-8",
+				DeletionProtection: true,
+			},
+		},
+	}
+}
+
+func generatePostgresqlConnectionPoolSample() {
+	spec := newPostgresqlConnectionPoolSample()
+	serialize(spec, true)
+}
+
+func newPostgresqlConnectionPoolSample() *exoscalev1.PostgreSQLConnectionPool {
+	return &exoscalev1.PostgreSQLConnectionPool{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: exoscalev1.PostgreSQLConnectionPoolGroupVersionKind.GroupVersion().String(),
+			Kind:       exoscalev1.PostgreSQLConnectionPoolKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "postgresql-connection-pool-local-dev"},
+		Spec: exoscalev1.PostgreSQLConnectionPoolSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference:          &xpv1.Reference{Name: "provider-config"},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: "postgresql-connection-pool-local-dev-details", Namespace: "default"},
+			},
+			ForProvider: exoscalev1.PostgreSQLConnectionPoolParameters{
+				Zone:        "ch-dk-2",
+				InstanceRef: &xpv1.Reference{Name: "postgresql-local-dev"},
+				DatabaseRef: &xpv1.Reference{Name: "postgresql-database-local-dev"},
+				UserRef:     &xpv1.Reference{Name: "postgresql-user-local-dev"},
+				Mode:        "transaction",
+				Size:        10,
+			},
+		},
+	}
+}
+
+func generateMysqlUserSample() {
+	spec := newMysqlUserSample()
+	serialize(spec, true)
+}
+
+func newMysqlUserSample() *exoscalev1.MySQLUser {
+	return &exoscalev1.MySQLUser{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: exoscalev1.MySQLUserGroupVersionKind.GroupVersion().String(),
+			Kind:       exoscalev1.MySQLUserKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "mysql-user-local-dev"},
+		Spec: exoscalev1.MySQLUserSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference:          &xpv1.Reference{Name: "provider-config"},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: "mysql-user-local-dev-details", Namespace: "default"},
+			},
+			ForProvider: exoscalev1.MySQLUserParameters{
+				Zone:                 "ch-dk-2",
+				InstanceRef:          &xpv1.Reference{Name: "mysql-local-dev"},
+				AuthenticationPlugin: "caching_sha2_password",
+			},
+		},
+	}
+}
+
+func generateMysqlDatabaseSample() {
+	spec := newMysqlDatabaseSample()
+	serialize(spec, true)
+}
+
+func newMysqlDatabaseSample() *exoscalev1.MySQLDatabase {
+	return &exoscalev1.MySQLDatabase{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: exoscalev1.MySQLDatabaseGroupVersionKind.GroupVersion().String(),
+			Kind:       exoscalev1.MySQLDatabaseKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "mysql-database-local-dev"},
+		Spec: exoscalev1.MySQLDatabaseSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: exoscalev1.MySQLDatabaseParameters{
+				Zone:               "ch-dk-2",
+				InstanceRef:        &xpv1.Reference{Name: "mysql-local-dev"},
+				DeletionProtection: true,
+			},
+		},
+	}
+}
+
 func generateMysqlSample() {
 	spec := newMysqlSample()
 	serialize(spec, true)
@@ -271,6 +414,94 @@ func newRedisSample() *exoscalev1.Redis {
 		},
 	}
 }
+
+func generateGrafanaSample() {
+	spec := newGrafanaSample()
+	serialize(spec, true)
+}
+
+func newGrafanaSample() *exoscalev1.Grafana {
+	return &exoscalev1.Grafana{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: exoscalev1.GrafanaGroupVersionKind.GroupVersion().String(),
+			Kind:       exoscalev1.GrafanaKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "grafana-local-dev"},
+		Spec: exoscalev1.GrafanaSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference:          &xpv1.Reference{Name: "provider-config"},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: "grafana-local-dev-details", Namespace: "default"},
+			},
+			ForProvider: exoscalev1.GrafanaParameters{
+				Maintenance: exoscalev1.MaintenanceSpec{
+					TimeOfDay: "12:00:00",
+					DayOfWeek: exoscalesdk.DBAASServiceMaintenanceDowMonday,
+				},
+				Zone: "ch-dk-2",
+				DBaaSParameters: exoscalev1.DBaaSParameters{
+					Size: exoscalev1.SizeSpec{
+						Plan: "hobbyist-2",
+					},
+					IPFilter: exoscalev1.IPFilter{"0.0.0.0/0"},
+				},
+				GrafanaSettings: runtime.RawExtension{Raw: []byte(`{"user_auto_assign_org_role":"Viewer"}`)},
+			},
+		},
+	}
+}
+
+func generateKafkaUserSample() {
+	spec := newKafkaUserSample()
+	serialize(spec, true)
+}
+
+func newKafkaUserSample() *exoscalev1.KafkaUser {
+	return &exoscalev1.KafkaUser{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: exoscalev1.KafkaUserGroupVersionKind.GroupVersion().String(),
+			Kind:       exoscalev1.KafkaUserKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka-user-local-dev"},
+		Spec: exoscalev1.KafkaUserSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference:          &xpv1.Reference{Name: "provider-config"},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: "kafka-user-local-dev-details", Namespace: "default"},
+			},
+			ForProvider: exoscalev1.KafkaUserParameters{
+				Zone:        "ch-dk-2",
+				InstanceRef: &xpv1.Reference{Name: "kafka-local-dev"},
+			},
+		},
+	}
+}
+
+func generateKafkaACLSample() {
+	spec := newKafkaACLSample()
+	serialize(spec, true)
+}
+
+func newKafkaACLSample() *exoscalev1.KafkaACL {
+	return &exoscalev1.KafkaACL{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: exoscalev1.KafkaACLGroupVersionKind.GroupVersion().String(),
+			Kind:       exoscalev1.KafkaACLKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka-acl-local-dev"},
+		Spec: exoscalev1.KafkaACLSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: exoscalev1.KafkaACLParameters{
+				Zone:        "ch-dk-2",
+				InstanceRef: &xpv1.Reference{Name: "kafka-local-dev"},
+				UserRef:     &xpv1.Reference{Name: "kafka-user-local-dev"},
+				Topic:       "orders-*",
+				Permission:  "readwrite",
+			},
+		},
+	}
+}
+
 func generateKafkaSample() {
 	spec := newKafkaSample()
 	serialize(spec, true)
@@ -345,6 +576,88 @@ func newOpensearchSample() *exoscalev1.OpenSearch {
 	}
 }
 
+func generateOpenSearchUserSample() {
+	spec := newOpenSearchUserSample()
+	serialize(spec, true)
+}
+
+func newOpenSearchUserSample() *exoscalev1.OpenSearchUser {
+	return &exoscalev1.OpenSearchUser{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: exoscalev1.OpenSearchUserGroupVersionKind.GroupVersion().String(),
+			Kind:       exoscalev1.OpenSearchUserKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "opensearch-user-local-dev"},
+		Spec: exoscalev1.OpenSearchUserSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference:          &xpv1.Reference{Name: "provider-config"},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: "opensearch-user-local-dev-details", Namespace: "default"},
+			},
+			ForProvider: exoscalev1.OpenSearchUserParameters{
+				Zone:        "ch-dk-2",
+				InstanceRef: &xpv1.Reference{Name: "opensearch-local-dev"},
+				ACLRules: []exoscalev1.OpenSearchACLRule{
+					{Index: "logs-*", Permission: "readwrite"},
+				},
+			},
+		},
+	}
+}
+
+func generateDBaaSIntegrationSample() {
+	spec := newDBaaSIntegrationSample()
+	serialize(spec, true)
+}
+
+func newDBaaSIntegrationSample() *exoscalev1.DBaaSIntegration {
+	return &exoscalev1.DBaaSIntegration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: exoscalev1.DBaaSIntegrationGroupVersionKind.GroupVersion().String(),
+			Kind:       exoscalev1.DBaaSIntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "grafana-datasource-local-dev"},
+		Spec: exoscalev1.DBaaSIntegrationSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: exoscalev1.DBaaSIntegrationParameters{
+				Zone:            "ch-dk-2",
+				IntegrationType: "datasource",
+				Source:          exoscalev1.DBaaSServiceReference{Kind: exoscalev1.GrafanaKind, Name: "grafana-local-dev"},
+				Destination:     exoscalev1.DBaaSServiceReference{Kind: exoscalev1.PostgreSQLKind, Name: "postgresql-local-dev"},
+			},
+		},
+	}
+}
+
+func generateDBaaSExternalEndpointSample() {
+	spec := newDBaaSExternalEndpointSample()
+	serialize(spec, true)
+}
+
+func newDBaaSExternalEndpointSample() *exoscalev1.DBaaSExternalEndpoint {
+	return &exoscalev1.DBaaSExternalEndpoint{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: exoscalev1.DBaaSExternalEndpointGroupVersionKind.GroupVersion().String(),
+			Kind:       exoscalev1.DBaaSExternalEndpointKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "prometheus-local-dev"},
+		Spec: exoscalev1.DBaaSExternalEndpointSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "provider-config"},
+			},
+			ForProvider: exoscalev1.DBaaSExternalEndpointParameters{
+				Zone: "ch-dk-2",
+				Type: "prometheus",
+				CredentialsSecretRef: &xpv1.SecretReference{
+					Name:      "prometheus-local-dev-credentials",
+					Namespace: "default",
+				},
+			},
+		},
+	}
+}
+
 func failIfError(err error) {
 	if err != nil {
 		log.Fatal(err)
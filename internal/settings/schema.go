@@ -6,10 +6,12 @@ import (
 
 	"github.com/vshn/provider-exoscale/operator/mapper"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 type Schemas interface {
 	SetDefaults(schema string, input runtime.RawExtension) (runtime.RawExtension, error)
+	Validate(schema string, input runtime.RawExtension, fldPath *field.Path) error
 }
 
 // ParseSchemas takes an object containing a map of json schemas and parses it
@@ -30,6 +32,20 @@ type schemas map[string]schema
 type schema struct {
 	Default    interface{}
 	Properties schemas
+
+	// The validation keywords are parsed leniently, keywords of unexpected shape are ignored.
+	Type                 types
+	Enum                 []interface{}
+	Minimum              limit
+	Maximum              limit
+	MinLength            limit
+	MaxLength            limit
+	Pattern              string
+	Items                json.RawMessage
+	MaxItems             limit
+	AnyOf                []schema
+	AdditionalProperties json.RawMessage
+	UserError            string `json:"user_error"`
 }
 
 // SetDefaults takes a setting for a DBaaS and will set the defaults of the schema with name `name`
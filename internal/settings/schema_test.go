@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/vshn/provider-exoscale/operator/mapper"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 var exampleSchemas = []byte(`{
@@ -109,3 +110,161 @@ func TestSetDefaultNested(t *testing.T) {
 	require.Truef(t, ok, "should set sub-sub object as map")
 	assert.EqualValues(t, 42, sub2Map["count"])
 }
+
+var validationSchemas = []byte(`{
+  "settings": {
+    "pg": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "max_connections": {
+          "type": "integer",
+          "minimum": 25,
+          "maximum": 10000
+        },
+        "bgwriter_lru_multiplier": {
+          "type": "number",
+          "minimum": 0,
+          "maximum": 10
+        },
+        "jit": {
+          "type": "boolean"
+        },
+        "temp_file_limit": {
+          "type": "integer",
+          "minimum": -1,
+          "maximum": "2147483647"
+        },
+        "timezone": {
+          "type": "string",
+          "maxLength": 8
+        },
+        "track_functions": {
+          "type": "string",
+          "enum": ["all", "pl", "none"]
+        },
+        "pg_partman_bgw.role": {
+          "type": "string",
+          "pattern": "^[_A-Za-z0-9][-._A-Za-z0-9]{0,63}$"
+        },
+        "wal_sender_timeout": {
+          "type": "integer",
+          "anyOf": [
+            {"minimum": 0, "maximum": 0},
+            {"minimum": 5000, "maximum": 10800000}
+          ],
+          "user_error": "Must be either 0 or between 5000 and 10800000."
+        },
+        "ignore_startup_parameters": {
+          "type": "array",
+          "maxItems": 2,
+          "items": {
+            "type": "string",
+            "enum": ["extra_float_digits", "search_path"]
+          }
+        },
+        "nullable": {
+          "type": ["string", "null"]
+        },
+        "migration": {
+          "type": "object",
+          "properties": {
+            "port": {
+              "type": "integer",
+              "maximum": 65535
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		given         string
+		expectedError string
+	}{
+		"Empty": {
+			given: ``,
+		},
+		"Valid": {
+			given: `{"max_connections": 100, "bgwriter_lru_multiplier": 2.5, "jit": true, "timezone": "UTC", "track_functions": "pl", "pg_partman_bgw.role": "admin", "wal_sender_timeout": 0, "ignore_startup_parameters": ["search_path"], "nullable": null, "migration": {"port": 5432}}`,
+		},
+		"WrongType": {
+			given:         `{"jit": "yes"}`,
+			expectedError: `spec.forProvider.pgSettings.jit: Invalid value: "yes": must be of type boolean`,
+		},
+		"NotAnInteger": {
+			given:         `{"max_connections": 100.5}`,
+			expectedError: `spec.forProvider.pgSettings.max_connections: Invalid value: 100.5: must be of type integer`,
+		},
+		"BelowMinimum": {
+			given:         `{"max_connections": 10}`,
+			expectedError: `spec.forProvider.pgSettings.max_connections: Invalid value: 10: must be greater than or equal to 25`,
+		},
+		"AboveStringMaximum": {
+			given:         `{"temp_file_limit": 2147483648}`,
+			expectedError: `spec.forProvider.pgSettings.temp_file_limit: Invalid value: 2.147483648e+09: must be less than or equal to 2.147483647e+09`,
+		},
+		"AboveMaximum": {
+			given:         `{"bgwriter_lru_multiplier": 10.5}`,
+			expectedError: `spec.forProvider.pgSettings.bgwriter_lru_multiplier: Invalid value: 10.5: must be less than or equal to 10`,
+		},
+		"TooLong": {
+			given:         `{"timezone": "Europe/Zurich"}`,
+			expectedError: `spec.forProvider.pgSettings.timezone: Too long: may not be more than 8 bytes`,
+		},
+		"NotInEnum": {
+			given:         `{"track_functions": "some"}`,
+			expectedError: `spec.forProvider.pgSettings.track_functions: Unsupported value: "some": supported values: "all", "pl", "none"`,
+		},
+		"PatternMismatch": {
+			given:         `{"pg_partman_bgw.role": "-admin"}`,
+			expectedError: `spec.forProvider.pgSettings.pg_partman_bgw.role: Invalid value: "-admin": must match the pattern ^[_A-Za-z0-9][-._A-Za-z0-9]{0,63}$`,
+		},
+		"NoneOfAnyOf": {
+			given:         `{"wal_sender_timeout": 1000}`,
+			expectedError: `spec.forProvider.pgSettings.wal_sender_timeout: Invalid value: 1000: Must be either 0 or between 5000 and 10800000.`,
+		},
+		"InvalidItem": {
+			given:         `{"ignore_startup_parameters": ["search_path", "timezone"]}`,
+			expectedError: `spec.forProvider.pgSettings.ignore_startup_parameters[1]: Unsupported value: "timezone": supported values: "extra_float_digits", "search_path"`,
+		},
+		"TooManyItems": {
+			given:         `{"ignore_startup_parameters": ["search_path", "search_path", "search_path"]}`,
+			expectedError: `spec.forProvider.pgSettings.ignore_startup_parameters: Too many: 3: must have at most 2 items`,
+		},
+		"UnknownKey": {
+			given:         `{"max_conections": 100}`,
+			expectedError: `spec.forProvider.pgSettings.max_conections: Forbidden: unknown setting`,
+		},
+		"NestedKey": {
+			given:         `{"migration": {"port": 70000, "other": true}}`,
+			expectedError: `spec.forProvider.pgSettings.migration.port: Invalid value: 70000: must be less than or equal to 65535`,
+		},
+		"MultipleErrors": {
+			given:         `{"jit": 1, "max_connections": 1}`,
+			expectedError: `[spec.forProvider.pgSettings.jit: Invalid value: 1: must be of type boolean, spec.forProvider.pgSettings.max_connections: Invalid value: 1: must be greater than or equal to 25]`,
+		},
+	}
+	schemas, err := ParseSchemas(validationSchemas)
+	require.NoError(t, err, "failed to parse validation schema")
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := schemas.Validate("pg", runtime.RawExtension{Raw: []byte(tc.given)}, field.NewPath("spec", "forProvider", "pgSettings"))
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateUnknownSchema(t *testing.T) {
+	schemas, err := ParseSchemas(validationSchemas)
+	require.NoError(t, err, "failed to parse validation schema")
+	err = schemas.Validate("mysql", runtime.RawExtension{}, field.NewPath("spec"))
+	assert.EqualError(t, err, `unknown schema: "mysql"`)
+}
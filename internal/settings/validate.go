@@ -0,0 +1,235 @@
+package settings
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// types are the allowed JSON types of a value.
+// The schemas give either a single type or a list of types.
+type types []string
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Types of unexpected shape are ignored.
+func (t *types) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = types{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err == nil {
+		*t = multiple
+	}
+	return nil
+}
+
+// limit is a numeric bound of a schema.
+// The schemas give bounds that exceed float64 precision as strings.
+type limit struct {
+	value float64
+	set   bool
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Bounds of unexpected shape are ignored.
+func (l *limit) UnmarshalJSON(data []byte) error {
+	var n float64
+	if err := json.Unmarshal(data, &n); err == nil {
+		*l = limit{value: n, set: true}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		if n, err := strconv.ParseFloat(s, 64); err == nil {
+			*l = limit{value: n, set: true}
+		}
+	}
+	return nil
+}
+
+// Validate validates the given settings against the schema with name `name`.
+// The errors name the offending key below the given field path.
+func (s schemas) Validate(name string, input runtime.RawExtension, fldPath *field.Path) error {
+	sc, ok := s[name]
+	if !ok {
+		return fmt.Errorf("unknown schema: %q", name)
+	}
+	if len(input.Raw) == 0 {
+		return nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(input.Raw, &value); err != nil {
+		return field.Invalid(fldPath, string(input.Raw), fmt.Sprintf("cannot parse settings: %s", err))
+	}
+	if value == nil {
+		return nil
+	}
+	return validate(sc, value, fldPath).ToAggregate()
+}
+
+func validate(sc schema, value interface{}, fldPath *field.Path) field.ErrorList {
+	if len(sc.Type) != 0 && !sc.Type.matches(value) {
+		return field.ErrorList{field.Invalid(fldPath, value, fmt.Sprintf("must be of type %s", strings.Join(sc.Type, " or ")))}
+	}
+	if len(sc.Enum) != 0 && !isOneOf(value, sc.Enum) {
+		return field.ErrorList{field.NotSupported(fldPath, value, enumValues(sc.Enum))}
+	}
+
+	var errs field.ErrorList
+	switch v := value.(type) {
+	case float64:
+		errs = append(errs, validateNumber(sc, v, fldPath)...)
+	case string:
+		errs = append(errs, validateString(sc, v, fldPath)...)
+	case []interface{}:
+		if sc.MaxItems.set && len(v) > int(sc.MaxItems.value) {
+			errs = append(errs, field.TooMany(fldPath, len(v), int(sc.MaxItems.value)))
+		}
+		var items schema
+		if json.Unmarshal(sc.Items, &items) == nil {
+			for i, item := range v {
+				errs = append(errs, validate(items, item, fldPath.Index(i))...)
+			}
+		}
+	case map[string]interface{}:
+		errs = append(errs, validateObject(sc, v, fldPath)...)
+	}
+	if len(errs) == 0 && len(sc.AnyOf) != 0 && !matchesAnyOf(sc.AnyOf, value, fldPath) {
+		errs = append(errs, field.Invalid(fldPath, value, sc.userError("must match one of the allowed ranges")))
+	}
+	return errs
+}
+
+func validateNumber(sc schema, v float64, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if sc.Minimum.set && v < sc.Minimum.value {
+		errs = append(errs, field.Invalid(fldPath, v, sc.userError(fmt.Sprintf("must be greater than or equal to %v", sc.Minimum.value))))
+	}
+	if sc.Maximum.set && v > sc.Maximum.value {
+		errs = append(errs, field.Invalid(fldPath, v, sc.userError(fmt.Sprintf("must be less than or equal to %v", sc.Maximum.value))))
+	}
+	return errs
+}
+
+func validateString(sc schema, v string, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if sc.MinLength.set && len(v) < int(sc.MinLength.value) {
+		errs = append(errs, field.Invalid(fldPath, v, sc.userError(fmt.Sprintf("must be at least %d characters long", int(sc.MinLength.value)))))
+	}
+	if sc.MaxLength.set && len(v) > int(sc.MaxLength.value) {
+		errs = append(errs, field.TooLong(fldPath, v, int(sc.MaxLength.value)))
+	}
+	if sc.Pattern != "" {
+		// Patterns that Go can't compile are skipped, the API still validates them.
+		if re, err := regexp.Compile(sc.Pattern); err == nil && !re.MatchString(v) {
+			errs = append(errs, field.Invalid(fldPath, v, sc.userError(fmt.Sprintf("must match the pattern %s", sc.Pattern))))
+		}
+	}
+	return errs
+}
+
+func validateObject(sc schema, v map[string]interface{}, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	// Sort the keys for stable error messages.
+	keys := make([]string, 0, len(v))
+	for key := range v {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		prop, ok := sc.Properties[key]
+		if !ok {
+			if !sc.allowsAdditionalProperties() {
+				errs = append(errs, field.Forbidden(fldPath.Child(key), "unknown setting"))
+			}
+			continue
+		}
+		errs = append(errs, validate(prop, v[key], fldPath.Child(key))...)
+	}
+	return errs
+}
+
+func matchesAnyOf(anyOf []schema, value interface{}, fldPath *field.Path) bool {
+	for _, sc := range anyOf {
+		if len(validate(sc, value, fldPath)) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// matches returns true if the given value is of one of the types.
+func (t types) matches(value interface{}) bool {
+	for _, typ := range t {
+		switch v := value.(type) {
+		case nil:
+			if typ == "null" {
+				return true
+			}
+		case bool:
+			if typ == "boolean" {
+				return true
+			}
+		case float64:
+			if typ == "number" || typ == "integer" && v == math.Trunc(v) {
+				return true
+			}
+		case string:
+			if typ == "string" {
+				return true
+			}
+		case []interface{}:
+			if typ == "array" {
+				return true
+			}
+		case map[string]interface{}:
+			if typ == "object" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// allowsAdditionalProperties returns false if additionalProperties is explicitly disabled.
+func (sc schema) allowsAdditionalProperties() bool {
+	var allowed bool
+	if json.Unmarshal(sc.AdditionalProperties, &allowed) != nil {
+		return true
+	}
+	return allowed
+}
+
+func (sc schema) userError(fallback string) string {
+	if sc.UserError != "" {
+		return sc.UserError
+	}
+	return fallback
+}
+
+func isOneOf(value interface{}, enum []interface{}) bool {
+	for _, e := range enum {
+		if reflect.DeepEqual(value, e) {
+			return true
+		}
+	}
+	return false
+}
+
+func enumValues(enum []interface{}) []string {
+	values := make([]string, len(enum))
+	for i, e := range enum {
+		values[i] = fmt.Sprint(e)
+	}
+	return values
+}
@@ -54,12 +54,12 @@ func (c *bucketConnector) Connect(ctx context.Context, mg resource.Managed) (man
 	if err != nil {
 		return nil, err
 	}
-	mc, err := c.createS3Client(exo, bucket.Status.EndpointURL)
+	mc, err := createS3Client(exo, bucket.Status.EndpointURL)
 	return NewProvisioningPipeline(c.kube, c.recorder, mc), err
 }
 
 // createS3Client creates a new client using the S3 credentials from the Secret.
-func (c *bucketConnector) createS3Client(connector *pipelineutil.ExoscaleConnector, endpointURL string) (*minio.Client, error) {
+func createS3Client(connector *pipelineutil.ExoscaleConnector, endpointURL string) (*minio.Client, error) {
 	parsed, err := url.Parse(endpointURL)
 	if err != nil {
 		return nil, err
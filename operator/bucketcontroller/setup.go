@@ -53,7 +53,8 @@ func SetupWebhook(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&exoscalev1.Bucket{}).
 		WithValidator(&BucketValidator{
-			log: mgr.GetLogger().WithName("webhook").WithName(strings.ToLower(exoscalev1.BucketKind)),
+			log:  mgr.GetLogger().WithName("webhook").WithName(strings.ToLower(exoscalev1.BucketKind)),
+			kube: mgr.GetClient(),
 		}).
 		Complete()
 }
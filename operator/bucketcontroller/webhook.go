@@ -3,16 +3,31 @@ package bucketcontroller
 import (
 	"context"
 	"fmt"
+	"time"
+
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/go-logr/logr"
+	"github.com/minio/minio-go/v7"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/pipelineutil"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // BucketValidator validates admission requests.
 type BucketValidator struct {
-	log logr.Logger
+	log  logr.Logger
+	kube client.Client
+}
+
+// emptyCheckTimeout bounds the time spent checking whether a bucket is empty on deletion.
+const emptyCheckTimeout = 3 * time.Second
+
+// objectLister lists the objects of a bucket.
+type objectLister interface {
+	ListObjects(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo
 }
 
 // ValidateCreate implements admission.CustomValidator.
@@ -51,8 +66,50 @@ func (v *BucketValidator) ValidateUpdate(_ context.Context, oldObj, newObj runti
 }
 
 // ValidateDelete implements admission.CustomValidator.
-func (v *BucketValidator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+// It warns if a bucket that is only deleted if empty still holds objects, as the deletion won't complete then.
+// The check is skipped if it doesn't finish within emptyCheckTimeout.
+func (v *BucketValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	res := obj.(*exoscalev1.Bucket)
-	v.log.V(1).Info("Validate delete (noop)", "name", res.Name)
+	v.log.V(1).Info("Validate delete", "name", res.Name)
+
+	bucketName := res.Status.AtProvider.BucketName
+	if bucketName == "" || res.GetDeletionPolicy() == xpv1.DeletionOrphan || res.Spec.ForProvider.BucketDeletionPolicy == exoscalev1.DeleteAll {
+		return nil, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, emptyCheckTimeout)
+	defer cancel()
+	lister, err := v.openS3Client(ctx, res)
+	if err == nil {
+		var empty bool
+		empty, err = isBucketEmpty(ctx, lister, bucketName)
+		if err == nil && !empty {
+			return admission.Warnings{fmt.Sprintf("bucket %q is not empty and is only deleted once all objects are removed, set .spec.forProvider.bucketDeletionPolicy=%s to delete them", bucketName, exoscalev1.DeleteAll)}, nil
+		}
+	}
+	if err != nil {
+		// Don't block the deletion if the bucket can't be inspected.
+		v.log.Info("Cannot determine whether bucket is empty", "name", res.Name, "error", err.Error())
+	}
 	return nil, nil
 }
+
+func (v *BucketValidator) openS3Client(ctx context.Context, bucket *exoscalev1.Bucket) (objectLister, error) {
+	exo, err := pipelineutil.OpenExoscaleClient(ctx, v.kube, bucket.GetProviderConfigName())
+	if err != nil {
+		return nil, err
+	}
+	return createS3Client(exo, getEndpointURL(bucket))
+}
+
+// isBucketEmpty returns true if the given bucket doesn't contain any object.
+func isBucketEmpty(ctx context.Context, lister objectLister, bucketName string) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for object := range lister.ListObjects(ctx, bucketName, minio.ListObjectsOptions{MaxKeys: 1}) {
+		if object.Err != nil {
+			return false, object.Err
+		}
+		return false, nil
+	}
+	return true, nil
+}
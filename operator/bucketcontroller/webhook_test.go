@@ -2,11 +2,13 @@ package bucketcontroller
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 	"github.com/go-logr/logr"
+	"github.com/minio/minio-go/v7"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
@@ -201,3 +203,87 @@ func TestBucketValidator_ValidateUpdate_PreventZoneChange(t *testing.T) {
 		})
 	}
 }
+
+type fakeObjectLister []minio.ObjectInfo
+
+func (l fakeObjectLister) ListObjects(ctx context.Context, _ string, _ minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+	ch := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(ch)
+		for _, object := range l {
+			select {
+			case ch <- object:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+func TestIsBucketEmpty(t *testing.T) {
+	tests := map[string]struct {
+		givenObjects  fakeObjectLister
+		expectedEmpty bool
+		expectedError string
+	}{
+		"GivenNoObjects_ThenExpectEmpty": {
+			expectedEmpty: true,
+		},
+		"GivenObjects_ThenExpectNotEmpty": {
+			givenObjects: fakeObjectLister{{Key: "a"}, {Key: "b"}},
+		},
+		"GivenListError_ThenExpectError": {
+			givenObjects:  fakeObjectLister{{Err: errors.New("access denied")}},
+			expectedError: "access denied",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			empty, err := isBucketEmpty(context.Background(), tc.givenObjects, "bucket")
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedEmpty, empty)
+		})
+	}
+}
+
+func TestBucketValidator_ValidateDelete_SkipInspection(t *testing.T) {
+	tests := map[string]struct {
+		bucketName     string
+		deletionPolicy xpv1.DeletionPolicy
+		bucketPolicy   exoscalev1.BucketDeletionPolicy
+	}{
+		"GivenBucketNotCreated_ThenExpectNoWarning": {
+			bucketPolicy: exoscalev1.DeleteIfEmpty,
+		},
+		"GivenDeleteAll_ThenExpectNoWarning": {
+			bucketName:   "bucket",
+			bucketPolicy: exoscalev1.DeleteAll,
+		},
+		"GivenOrphan_ThenExpectNoWarning": {
+			bucketName:     "bucket",
+			deletionPolicy: xpv1.DeletionOrphan,
+			bucketPolicy:   exoscalev1.DeleteIfEmpty,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			bucket := &exoscalev1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+				Spec: exoscalev1.BucketSpec{
+					ResourceSpec: xpv1.ResourceSpec{DeletionPolicy: tc.deletionPolicy},
+					ForProvider:  exoscalev1.BucketParameters{BucketDeletionPolicy: tc.bucketPolicy},
+				},
+				Status: exoscalev1.BucketStatus{AtProvider: exoscalev1.BucketObservation{BucketName: tc.bucketName}},
+			}
+			v := &BucketValidator{log: logr.Discard()}
+			warnings, err := v.ValidateDelete(context.Background(), bucket)
+			require.NoError(t, err)
+			assert.Empty(t, warnings)
+		})
+	}
+}
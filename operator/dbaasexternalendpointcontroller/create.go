@@ -0,0 +1,38 @@
+package dbaasexternalendpointcontroller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *pipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	endpoint := mg.(*exoscalev1.DBaaSExternalEndpoint)
+
+	// The version of the credentials isn't recorded here, since status changes are lost after creation.
+	// The next observation finds the version missing and the update records it.
+	creds, _, err := p.fetchCredentials(ctx, endpoint)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	resp, err := createEndpoint(ctx, p.exo, endpoint.GetEndpointName(), endpoint.Spec.ForProvider, creds)
+	if err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			// According to the ExternalClient Interface, create needs to be idempotent.
+			// However the exoscale client doesn't return very helpful errors, so we need to make this brittle matching to find if we get an already exits error
+			return managed.ExternalCreation{}, nil
+		}
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create external endpoint")
+	}
+	log.V(1).Info("Response", "message", resp.Message)
+	return managed.ExternalCreation{}, nil
+}
@@ -0,0 +1,35 @@
+package dbaasexternalendpointcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *pipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	endpoint := mg.(*exoscalev1.DBaaSExternalEndpoint)
+	id := endpoint.Status.AtProvider.ID
+	if id == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	resp, err := deleteEndpoint(ctx, p.exo, endpoint.Spec.ForProvider.Type, id)
+	if err != nil {
+		if errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, fmt.Errorf("cannot delete external endpoint: %w", err)
+	}
+	log.V(1).Info("Response when deleting", "message", resp.Message)
+	return managed.ExternalDelete{}, nil
+}
@@ -0,0 +1,7 @@
+package dbaasexternalendpointcontroller
+
+import "context"
+
+func (p *pipeline) Disconnect(ctx context.Context) error {
+	return nil
+}
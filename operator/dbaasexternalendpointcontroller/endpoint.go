@@ -0,0 +1,245 @@
+package dbaasexternalendpointcontroller
+
+import (
+	"context"
+	"fmt"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"k8s.io/utils/ptr"
+)
+
+// credentials contains the data of the credentials secret of an endpoint.
+type credentials map[string][]byte
+
+func (c credentials) get(key string) string {
+	return string(c[key])
+}
+
+// createEndpoint creates an endpoint of the type given in the parameters.
+func createEndpoint(ctx context.Context, exo *exoscalesdk.Client, name string, params exoscalev1.DBaaSExternalEndpointParameters, creds credentials) (*exoscalesdk.Operation, error) {
+	switch params.Type {
+	case string(exoscalesdk.EnumExternalEndpointTypesPrometheus):
+		return exo.CreateDBAASExternalEndpointPrometheus(ctx, name, prometheusPayload(creds))
+	case string(exoscalesdk.EnumExternalEndpointTypesDatadog):
+		s := ptr.Deref(params.Datadog, exoscalev1.DatadogEndpointSettings{})
+		return exo.CreateDBAASExternalEndpointDatadog(ctx, name, exoscalesdk.DBAASEndpointDatadogInputCreate{
+			Settings: &exoscalesdk.DBAASEndpointDatadogInputCreateSettings{
+				DatadogAPIKey:               creds.get(exoscalev1.ExternalEndpointAPIKeyKey),
+				DatadogTags:                 toDatadogTags(s.Tags),
+				DisableConsumerStats:        &s.DisableConsumerStats,
+				KafkaConsumerCheckInstances: s.KafkaConsumerCheckInstances,
+				KafkaConsumerStatsTimeout:   s.KafkaConsumerStatsTimeout,
+				MaxPartitionContexts:        s.MaxPartitionContexts,
+				Site:                        exoscalesdk.EnumDatadogSite(s.Site),
+			},
+		})
+	case string(exoscalesdk.EnumExternalEndpointTypesElasticsearch):
+		s := ptr.Deref(params.Elasticsearch, exoscalev1.IndexEndpointSettings{})
+		return exo.CreateDBAASExternalEndpointElasticsearch(ctx, name, exoscalesdk.DBAASEndpointElasticsearchInputCreate{
+			Settings: &exoscalesdk.DBAASEndpointElasticsearchInputCreateSettings{
+				CA:           creds.get(exoscalev1.ExternalEndpointCAKey),
+				IndexDaysMax: s.IndexDaysMax,
+				IndexPrefix:  s.IndexPrefix,
+				Timeout:      s.Timeout,
+				URL:          creds.get(exoscalev1.ExternalEndpointURLKey),
+			},
+		})
+	case string(exoscalesdk.EnumExternalEndpointTypesOpensearch):
+		s := ptr.Deref(params.OpenSearch, exoscalev1.IndexEndpointSettings{})
+		return exo.CreateDBAASExternalEndpointOpensearch(ctx, name, exoscalesdk.DBAASEndpointOpensearchInputCreate{
+			Settings: &exoscalesdk.DBAASEndpointOpensearchInputCreateSettings{
+				CA:           creds.get(exoscalev1.ExternalEndpointCAKey),
+				IndexDaysMax: s.IndexDaysMax,
+				IndexPrefix:  s.IndexPrefix,
+				Timeout:      s.Timeout,
+				URL:          creds.get(exoscalev1.ExternalEndpointURLKey),
+			},
+		})
+	case string(exoscalesdk.EnumExternalEndpointTypesRsyslog):
+		s := ptr.Deref(params.Rsyslog, exoscalev1.RsyslogEndpointSettings{})
+		return exo.CreateDBAASExternalEndpointRsyslog(ctx, name, exoscalesdk.DBAASEndpointRsyslogInputCreate{
+			Settings: &exoscalesdk.DBAASEndpointRsyslogInputCreateSettings{
+				CA:             creds.get(exoscalev1.ExternalEndpointCAKey),
+				Cert:           creds.get(exoscalev1.ExternalEndpointCertKey),
+				Format:         exoscalesdk.EnumRsyslogFormat(s.Format),
+				Key:            creds.get(exoscalev1.ExternalEndpointKeyKey),
+				Logline:        s.Logline,
+				MaxMessageSize: s.MaxMessageSize,
+				Port:           s.Port,
+				SD:             s.SD,
+				Server:         s.Server,
+				Tls:            &s.TLS,
+			},
+		})
+	}
+	return nil, fmt.Errorf("unsupported endpoint type %q", params.Type)
+}
+
+// updateEndpoint updates the endpoint with the given ID.
+func updateEndpoint(ctx context.Context, exo *exoscalesdk.Client, id string, params exoscalev1.DBaaSExternalEndpointParameters, creds credentials) (*exoscalesdk.Operation, error) {
+	uuid := exoscalesdk.UUID(id)
+	switch params.Type {
+	case string(exoscalesdk.EnumExternalEndpointTypesPrometheus):
+		return exo.UpdateDBAASExternalEndpointPrometheus(ctx, uuid, prometheusPayload(creds))
+	case string(exoscalesdk.EnumExternalEndpointTypesDatadog):
+		s := ptr.Deref(params.Datadog, exoscalev1.DatadogEndpointSettings{})
+		return exo.UpdateDBAASExternalEndpointDatadog(ctx, uuid, exoscalesdk.DBAASEndpointDatadogInputUpdate{
+			Settings: &exoscalesdk.DBAASEndpointDatadogInputUpdateSettings{
+				DatadogAPIKey:               creds.get(exoscalev1.ExternalEndpointAPIKeyKey),
+				DatadogTags:                 toDatadogTags(s.Tags),
+				DisableConsumerStats:        &s.DisableConsumerStats,
+				KafkaConsumerCheckInstances: s.KafkaConsumerCheckInstances,
+				KafkaConsumerStatsTimeout:   s.KafkaConsumerStatsTimeout,
+				MaxPartitionContexts:        s.MaxPartitionContexts,
+				Site:                        exoscalesdk.EnumDatadogSite(s.Site),
+			},
+		})
+	case string(exoscalesdk.EnumExternalEndpointTypesElasticsearch):
+		s := ptr.Deref(params.Elasticsearch, exoscalev1.IndexEndpointSettings{})
+		return exo.UpdateDBAASExternalEndpointElasticsearch(ctx, uuid, exoscalesdk.DBAASEndpointElasticsearchInputUpdate{
+			Settings: &exoscalesdk.DBAASEndpointElasticsearchInputUpdateSettings{
+				CA:           creds.get(exoscalev1.ExternalEndpointCAKey),
+				IndexDaysMax: s.IndexDaysMax,
+				IndexPrefix:  s.IndexPrefix,
+				Timeout:      s.Timeout,
+				URL:          creds.get(exoscalev1.ExternalEndpointURLKey),
+			},
+		})
+	case string(exoscalesdk.EnumExternalEndpointTypesOpensearch):
+		s := ptr.Deref(params.OpenSearch, exoscalev1.IndexEndpointSettings{})
+		return exo.UpdateDBAASExternalEndpointOpensearch(ctx, uuid, exoscalesdk.DBAASEndpointOpensearchInputUpdate{
+			Settings: &exoscalesdk.DBAASEndpointOpensearchInputUpdateSettings{
+				CA:           creds.get(exoscalev1.ExternalEndpointCAKey),
+				IndexDaysMax: s.IndexDaysMax,
+				IndexPrefix:  s.IndexPrefix,
+				Timeout:      s.Timeout,
+				URL:          creds.get(exoscalev1.ExternalEndpointURLKey),
+			},
+		})
+	case string(exoscalesdk.EnumExternalEndpointTypesRsyslog):
+		s := ptr.Deref(params.Rsyslog, exoscalev1.RsyslogEndpointSettings{})
+		return exo.UpdateDBAASExternalEndpointRsyslog(ctx, uuid, exoscalesdk.DBAASEndpointRsyslogInputUpdate{
+			Settings: &exoscalesdk.DBAASEndpointRsyslogInputUpdateSettings{
+				CA:             creds.get(exoscalev1.ExternalEndpointCAKey),
+				Cert:           creds.get(exoscalev1.ExternalEndpointCertKey),
+				Format:         exoscalesdk.EnumRsyslogFormat(s.Format),
+				Key:            creds.get(exoscalev1.ExternalEndpointKeyKey),
+				Logline:        s.Logline,
+				MaxMessageSize: s.MaxMessageSize,
+				Port:           s.Port,
+				SD:             s.SD,
+				Server:         s.Server,
+				Tls:            &s.TLS,
+			},
+		})
+	}
+	return nil, fmt.Errorf("unsupported endpoint type %q", params.Type)
+}
+
+// getEndpointSettings returns the parameters of the endpoint with the given ID.
+// Credentials are not returned by the API and hence not part of the parameters.
+func getEndpointSettings(ctx context.Context, exo *exoscalesdk.Client, endpointType, id string) (exoscalev1.DBaaSExternalEndpointParameters, error) {
+	uuid := exoscalesdk.UUID(id)
+	params := exoscalev1.DBaaSExternalEndpointParameters{Type: endpointType}
+	switch endpointType {
+	case string(exoscalesdk.EnumExternalEndpointTypesPrometheus):
+		_, err := exo.GetDBAASExternalEndpointPrometheus(ctx, uuid)
+		return params, err
+	case string(exoscalesdk.EnumExternalEndpointTypesDatadog):
+		ep, err := exo.GetDBAASExternalEndpointDatadog(ctx, uuid)
+		if err != nil {
+			return params, err
+		}
+		params.Datadog = fromDatadogSettings(ep.Settings)
+		return params, nil
+	case string(exoscalesdk.EnumExternalEndpointTypesElasticsearch):
+		ep, err := exo.GetDBAASExternalEndpointElasticsearch(ctx, uuid)
+		if err != nil {
+			return params, err
+		}
+		if s := ep.Settings; s != nil {
+			params.Elasticsearch = &exoscalev1.IndexEndpointSettings{IndexPrefix: s.IndexPrefix, IndexDaysMax: s.IndexDaysMax, Timeout: s.Timeout}
+		}
+		return params, nil
+	case string(exoscalesdk.EnumExternalEndpointTypesOpensearch):
+		ep, err := exo.GetDBAASExternalEndpointOpensearch(ctx, uuid)
+		if err != nil {
+			return params, err
+		}
+		if s := ep.Settings; s != nil {
+			params.OpenSearch = &exoscalev1.IndexEndpointSettings{IndexPrefix: s.IndexPrefix, IndexDaysMax: s.IndexDaysMax, Timeout: s.Timeout}
+		}
+		return params, nil
+	case string(exoscalesdk.EnumExternalEndpointTypesRsyslog):
+		ep, err := exo.GetDBAASExternalEndpointRsyslog(ctx, uuid)
+		if err != nil {
+			return params, err
+		}
+		if s := ep.Settings; s != nil {
+			params.Rsyslog = &exoscalev1.RsyslogEndpointSettings{
+				Server:         s.Server,
+				Port:           s.Port,
+				TLS:            ptr.Deref(s.Tls, false),
+				Format:         string(s.Format),
+				Logline:        s.Logline,
+				SD:             s.SD,
+				MaxMessageSize: s.MaxMessageSize,
+			}
+		}
+		return params, nil
+	}
+	return params, fmt.Errorf("unsupported endpoint type %q", endpointType)
+}
+
+// deleteEndpoint deletes the endpoint with the given ID.
+func deleteEndpoint(ctx context.Context, exo *exoscalesdk.Client, endpointType, id string) (*exoscalesdk.Operation, error) {
+	uuid := exoscalesdk.UUID(id)
+	switch endpointType {
+	case string(exoscalesdk.EnumExternalEndpointTypesPrometheus):
+		return exo.DeleteDBAASExternalEndpointPrometheus(ctx, uuid)
+	case string(exoscalesdk.EnumExternalEndpointTypesDatadog):
+		return exo.DeleteDBAASExternalEndpointDatadog(ctx, uuid)
+	case string(exoscalesdk.EnumExternalEndpointTypesElasticsearch):
+		return exo.DeleteDBAASExternalEndpointElasticsearch(ctx, uuid)
+	case string(exoscalesdk.EnumExternalEndpointTypesOpensearch):
+		return exo.DeleteDBAASExternalEndpointOpensearch(ctx, uuid)
+	case string(exoscalesdk.EnumExternalEndpointTypesRsyslog):
+		return exo.DeleteDBAASExternalEndpointRsyslog(ctx, uuid)
+	}
+	return nil, fmt.Errorf("unsupported endpoint type %q", endpointType)
+}
+
+func prometheusPayload(creds credentials) exoscalesdk.DBAASEndpointPrometheusPayload {
+	return exoscalesdk.DBAASEndpointPrometheusPayload{
+		Settings: &exoscalesdk.DBAASEndpointPrometheusPayloadSettings{
+			BasicAuthUsername: creds.get(exoscalev1.ExternalEndpointUsernameKey),
+			BasicAuthPassword: creds.get(exoscalev1.ExternalEndpointPasswordKey),
+		},
+	}
+}
+
+func toDatadogTags(tags []exoscalev1.DatadogTag) []exoscalesdk.DBAASDatadogTag {
+	res := make([]exoscalesdk.DBAASDatadogTag, 0, len(tags))
+	for _, t := range tags {
+		res = append(res, exoscalesdk.DBAASDatadogTag{Tag: t.Tag, Comment: t.Comment})
+	}
+	return res
+}
+
+func fromDatadogSettings(s *exoscalesdk.DBAASExternalEndpointDatadogOutputSettings) *exoscalev1.DatadogEndpointSettings {
+	if s == nil {
+		return nil
+	}
+	res := &exoscalev1.DatadogEndpointSettings{
+		Site:                        string(s.Site),
+		DisableConsumerStats:        ptr.Deref(s.DisableConsumerStats, false),
+		KafkaConsumerCheckInstances: s.KafkaConsumerCheckInstances,
+		KafkaConsumerStatsTimeout:   s.KafkaConsumerStatsTimeout,
+		MaxPartitionContexts:        s.MaxPartitionContexts,
+	}
+	for _, t := range s.DatadogTags {
+		res.Tags = append(res.Tags, exoscalev1.DatadogTag{Tag: t.Tag, Comment: t.Comment})
+	}
+	return res
+}
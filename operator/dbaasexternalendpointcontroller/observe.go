@@ -0,0 +1,143 @@
+package dbaasexternalendpointcontroller
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	endpoint := mg.(*exoscalev1.DBaaSExternalEndpoint)
+	spec := endpoint.Spec.ForProvider
+
+	endpoints, err := p.exo.ListDBAASExternalEndpoints(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("cannot list external endpoints: %w", err)
+	}
+	observed := findEndpoint(endpoints.DBAASEndpoints, endpoint.GetEndpointName(), spec.Type)
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	endpoint.Status.AtProvider.ID = string(observed.ID)
+	endpoint.Status.AtProvider.Name = observed.Name
+	endpoint.Status.AtProvider.Type = string(observed.Type)
+
+	params, err := getEndpointSettings(ctx, p.exo, spec.Type, string(observed.ID))
+	if err != nil {
+		if errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, fmt.Errorf("cannot get external endpoint: %w", err)
+	}
+
+	_, version, err := p.fetchCredentials(ctx, endpoint)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	endpoint.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: version == endpoint.Status.AtProvider.CredentialsVersion && isUpToDate(spec, params),
+	}, nil
+}
+
+// fetchCredentials returns the data and resource version of the credentials secret.
+// Returns empty credentials if no secret is referenced.
+func (p *pipeline) fetchCredentials(ctx context.Context, endpoint *exoscalev1.DBaaSExternalEndpoint) (credentials, string, error) {
+	ref := endpoint.Spec.ForProvider.CredentialsSecretRef
+	if ref == nil {
+		return credentials{}, "", nil
+	}
+	secret := &corev1.Secret{}
+	err := p.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot get credentials secret: %w", err)
+	}
+	return secret.Data, secret.ResourceVersion, nil
+}
+
+func findEndpoint(endpoints []exoscalesdk.DBAASExternalEndpoint, name, endpointType string) *exoscalesdk.DBAASExternalEndpoint {
+	for _, e := range endpoints {
+		if e.Name == name && string(e.Type) == endpointType {
+			return &e
+		}
+	}
+	return nil
+}
+
+// isUpToDate returns true if the observed settings match the desired spec.
+// Optional settings that aren't given in the spec are defaulted by the provider and ignored.
+func isUpToDate(spec, observed exoscalev1.DBaaSExternalEndpointParameters) bool {
+	switch spec.Type {
+	case string(exoscalesdk.EnumExternalEndpointTypesDatadog):
+		return isSameDatadog(spec.Datadog, observed.Datadog)
+	case string(exoscalesdk.EnumExternalEndpointTypesElasticsearch):
+		return isSameIndex(spec.Elasticsearch, observed.Elasticsearch)
+	case string(exoscalesdk.EnumExternalEndpointTypesOpensearch):
+		return isSameIndex(spec.OpenSearch, observed.OpenSearch)
+	case string(exoscalesdk.EnumExternalEndpointTypesRsyslog):
+		return isSameRsyslog(spec.Rsyslog, observed.Rsyslog)
+	}
+	return true
+}
+
+func isSameDatadog(spec, observed *exoscalev1.DatadogEndpointSettings) bool {
+	if spec == nil || observed == nil {
+		return spec == observed
+	}
+	if len(spec.Tags) != len(observed.Tags) {
+		return false
+	}
+	for i := range spec.Tags {
+		if spec.Tags[i] != observed.Tags[i] {
+			return false
+		}
+	}
+	return spec.Site == observed.Site &&
+		spec.DisableConsumerStats == observed.DisableConsumerStats &&
+		isSameOrUnset(spec.KafkaConsumerCheckInstances, observed.KafkaConsumerCheckInstances) &&
+		isSameOrUnset(spec.KafkaConsumerStatsTimeout, observed.KafkaConsumerStatsTimeout) &&
+		isSameOrUnset(spec.MaxPartitionContexts, observed.MaxPartitionContexts)
+}
+
+func isSameIndex(spec, observed *exoscalev1.IndexEndpointSettings) bool {
+	if spec == nil || observed == nil {
+		return spec == observed
+	}
+	return spec.IndexPrefix == observed.IndexPrefix &&
+		isSameOrUnset(spec.IndexDaysMax, observed.IndexDaysMax) &&
+		isSameOrUnset(spec.Timeout, observed.Timeout)
+}
+
+func isSameRsyslog(spec, observed *exoscalev1.RsyslogEndpointSettings) bool {
+	if spec == nil || observed == nil {
+		return spec == observed
+	}
+	return spec.Server == observed.Server &&
+		spec.Port == observed.Port &&
+		spec.TLS == observed.TLS &&
+		spec.Format == observed.Format &&
+		spec.Logline == observed.Logline &&
+		spec.SD == observed.SD &&
+		isSameOrUnset(spec.MaxMessageSize, observed.MaxMessageSize)
+}
+
+// isSameOrUnset returns true if the desired value is unset or equals the observed value.
+func isSameOrUnset(desired, observed int64) bool {
+	return desired == 0 || desired == observed
+}
@@ -0,0 +1,56 @@
+package dbaasexternalendpointcontroller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	observedIndex := &exoscalev1.IndexEndpointSettings{IndexPrefix: "logs", IndexDaysMax: 3, Timeout: 10}
+
+	tests := map[string]struct {
+		spec, observed exoscalev1.DBaaSExternalEndpointParameters
+		expected       bool
+	}{
+		"Prometheus": {
+			spec:     exoscalev1.DBaaSExternalEndpointParameters{Type: "prometheus"},
+			observed: exoscalev1.DBaaSExternalEndpointParameters{Type: "prometheus"},
+			expected: true,
+		},
+		"DefaultedByProvider": {
+			spec:     exoscalev1.DBaaSExternalEndpointParameters{Type: "opensearch", OpenSearch: &exoscalev1.IndexEndpointSettings{IndexPrefix: "logs"}},
+			observed: exoscalev1.DBaaSExternalEndpointParameters{Type: "opensearch", OpenSearch: observedIndex},
+			expected: true,
+		},
+		"DifferentIndexDays": {
+			spec:     exoscalev1.DBaaSExternalEndpointParameters{Type: "opensearch", OpenSearch: &exoscalev1.IndexEndpointSettings{IndexPrefix: "logs", IndexDaysMax: 7}},
+			observed: exoscalev1.DBaaSExternalEndpointParameters{Type: "opensearch", OpenSearch: observedIndex},
+			expected: false,
+		},
+		"DatadogTags": {
+			spec: exoscalev1.DBaaSExternalEndpointParameters{Type: "datadog", Datadog: &exoscalev1.DatadogEndpointSettings{
+				Site: "datadoghq.eu", Tags: []exoscalev1.DatadogTag{{Tag: "env:prod"}},
+			}},
+			observed: exoscalev1.DBaaSExternalEndpointParameters{Type: "datadog", Datadog: &exoscalev1.DatadogEndpointSettings{
+				Site: "datadoghq.eu", MaxPartitionContexts: 200,
+			}},
+			expected: false,
+		},
+		"Rsyslog": {
+			spec: exoscalev1.DBaaSExternalEndpointParameters{Type: "rsyslog", Rsyslog: &exoscalev1.RsyslogEndpointSettings{
+				Server: "logs.example.com", Port: 514, Format: "rfc5424", TLS: true,
+			}},
+			observed: exoscalev1.DBaaSExternalEndpointParameters{Type: "rsyslog", Rsyslog: &exoscalev1.RsyslogEndpointSettings{
+				Server: "logs.example.com", Port: 514, Format: "rfc5424", TLS: true, MaxMessageSize: 8192,
+			}},
+			expected: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isUpToDate(tc.spec, tc.observed))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package dbaasexternalendpointcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+// The credentials are always sent along, since they can't be observed.
+func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Updating resource")
+
+	endpoint := mg.(*exoscalev1.DBaaSExternalEndpoint)
+
+	creds, version, err := p.fetchCredentials(ctx, endpoint)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	resp, err := updateEndpoint(ctx, p.exo, endpoint.Status.AtProvider.ID, endpoint.Spec.ForProvider, creds)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update external endpoint")
+	}
+	endpoint.Status.AtProvider.CredentialsVersion = version
+	log.V(1).Info("Response", "message", resp.Message)
+	return managed.ExternalUpdate{}, nil
+}
@@ -0,0 +1,95 @@
+package dbaasexternalendpointcontroller
+
+import (
+	"context"
+	"fmt"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/go-logr/logr"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Validator validates admission requests.
+type Validator struct {
+	log logr.Logger
+}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	endpoint, ok := obj.(*exoscalev1.DBaaSExternalEndpoint)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for dbaas external endpoint webhook", obj)
+	}
+	v.log.V(1).Info("Validate create", "name", endpoint.Name)
+
+	return nil, validateSpec(endpoint.Spec.ForProvider)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	newEndpoint, ok := newObj.(*exoscalev1.DBaaSExternalEndpoint)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for dbaas external endpoint webhook", newObj)
+	}
+	oldEndpoint, ok := oldObj.(*exoscalev1.DBaaSExternalEndpoint)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for dbaas external endpoint webhook", oldObj)
+	}
+	v.log.V(1).Info("Validate update", "name", newEndpoint.Name)
+
+	err := validateSpec(newEndpoint.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	return nil, validateImmutable(oldEndpoint.Spec.ForProvider, newEndpoint.Spec.ForProvider)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate delete (noop)")
+	return nil, nil
+}
+
+// validateSpec checks that exactly the settings of the given type are set and that credentials are given if the type requires them.
+func validateSpec(params exoscalev1.DBaaSExternalEndpointParameters) error {
+	settings := map[string]bool{
+		string(exoscalesdk.EnumExternalEndpointTypesDatadog):       params.Datadog != nil,
+		string(exoscalesdk.EnumExternalEndpointTypesElasticsearch): params.Elasticsearch != nil,
+		string(exoscalesdk.EnumExternalEndpointTypesOpensearch):    params.OpenSearch != nil,
+		string(exoscalesdk.EnumExternalEndpointTypesRsyslog):       params.Rsyslog != nil,
+	}
+	for t, set := range settings {
+		if t == params.Type && !set {
+			return fmt.Errorf("%s settings are required for type %s", t, params.Type)
+		}
+		if t != params.Type && set {
+			return fmt.Errorf("%s settings are not allowed for type %s", t, params.Type)
+		}
+	}
+
+	ref := params.CredentialsSecretRef
+	switch params.Type {
+	case string(exoscalesdk.EnumExternalEndpointTypesDatadog),
+		string(exoscalesdk.EnumExternalEndpointTypesElasticsearch),
+		string(exoscalesdk.EnumExternalEndpointTypesOpensearch):
+		if ref == nil {
+			return fmt.Errorf("credentialsSecretRef is required for type %s", params.Type)
+		}
+	}
+	if ref != nil && (ref.Name == "" || ref.Namespace == "") {
+		return fmt.Errorf("credentialsSecretRef requires name and namespace")
+	}
+	return nil
+}
+
+func validateImmutable(oldParams, newParams exoscalev1.DBaaSExternalEndpointParameters) error {
+	if oldParams.Zone != newParams.Zone {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.Zone, newParams.Zone)
+	}
+	if oldParams.Type != newParams.Type {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.Type, newParams.Type)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package dbaasexternalendpointcontroller
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/stretchr/testify/assert"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+func TestValidateSpec(t *testing.T) {
+	secretRef := &xpv1.SecretReference{Name: "creds", Namespace: "default"}
+
+	tests := map[string]struct {
+		params        exoscalev1.DBaaSExternalEndpointParameters
+		expectedError string
+	}{
+		"Prometheus": {
+			params: exoscalev1.DBaaSExternalEndpointParameters{Type: "prometheus"},
+		},
+		"Datadog": {
+			params: exoscalev1.DBaaSExternalEndpointParameters{Type: "datadog", Datadog: &exoscalev1.DatadogEndpointSettings{Site: "datadoghq.eu"}, CredentialsSecretRef: secretRef},
+		},
+		"MissingSettings": {
+			params:        exoscalev1.DBaaSExternalEndpointParameters{Type: "rsyslog"},
+			expectedError: "rsyslog settings are required for type rsyslog",
+		},
+		"OtherSettings": {
+			params:        exoscalev1.DBaaSExternalEndpointParameters{Type: "opensearch", OpenSearch: &exoscalev1.IndexEndpointSettings{}, Elasticsearch: &exoscalev1.IndexEndpointSettings{}, CredentialsSecretRef: secretRef},
+			expectedError: "elasticsearch settings are not allowed for type opensearch",
+		},
+		"MissingCredentials": {
+			params:        exoscalev1.DBaaSExternalEndpointParameters{Type: "elasticsearch", Elasticsearch: &exoscalev1.IndexEndpointSettings{}},
+			expectedError: "credentialsSecretRef is required for type elasticsearch",
+		},
+		"IncompleteCredentials": {
+			params:        exoscalev1.DBaaSExternalEndpointParameters{Type: "prometheus", CredentialsSecretRef: &xpv1.SecretReference{Name: "creds"}},
+			expectedError: "credentialsSecretRef requires name and namespace",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateSpec(tc.params)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package dbaasintegrationcontroller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/mapper"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *pipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	integration := mg.(*exoscalev1.DBaaSIntegration)
+	spec := integration.Spec.ForProvider
+
+	source, dest, err := p.serviceNames(ctx, spec)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	settings, err := mapper.ToMap(spec.Settings)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot map integration settings")
+	}
+
+	resp, err := p.exo.CreateDBAASIntegration(ctx, exoscalesdk.CreateDBAASIntegrationRequest{
+		IntegrationType: exoscalesdk.EnumIntegrationTypes(spec.IntegrationType),
+		SourceService:   exoscalesdk.DBAASServiceName(source),
+		DestService:     exoscalesdk.DBAASServiceName(dest),
+		Settings:        settings,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			// According to the ExternalClient Interface, create needs to be idempotent.
+			// However the exoscale client doesn't return very helpful errors, so we need to make this brittle matching to find if we get an already exits error
+			return managed.ExternalCreation{}, nil
+		}
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create integration")
+	}
+	log.V(1).Info("Response", "message", resp.Message)
+	return managed.ExternalCreation{}, nil
+}
@@ -0,0 +1,35 @@
+package dbaasintegrationcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+func (p *pipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	integration := mg.(*exoscalev1.DBaaSIntegration)
+	id := integration.Status.AtProvider.ID
+	if id == "" {
+		return managed.ExternalDelete{}, nil
+	}
+
+	resp, err := p.exo.DeleteDBAASIntegration(ctx, exoscalesdk.UUID(id))
+	if err != nil {
+		if errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, fmt.Errorf("cannot delete integration: %w", err)
+	}
+	log.V(1).Info("Response when deleting", "message", resp.Message)
+	return managed.ExternalDelete{}, nil
+}
@@ -0,0 +1,125 @@
+package dbaasintegrationcontroller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/mapper"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	integration := mg.(*exoscalev1.DBaaSIntegration)
+
+	observed, err := p.findIntegration(ctx, integration)
+	if err != nil {
+		if errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, err
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	integration.Status.AtProvider, err = mapObservation(observed)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	integration.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(integration.Spec.ForProvider.Settings, integration.Status.AtProvider.Settings),
+	}, nil
+}
+
+// findIntegration returns the integration of the given resource.
+// Once created, the integration is looked up by its ID.
+// Otherwise, the integrations of the source service are searched for one of the same type and destination.
+// Returns nil if there is no such integration.
+func (p *pipeline) findIntegration(ctx context.Context, integration *exoscalev1.DBaaSIntegration) (*exoscalesdk.DBAASIntegration, error) {
+	if id := integration.Status.AtProvider.ID; id != "" {
+		observed, err := p.exo.GetDBAASIntegration(ctx, exoscalesdk.UUID(id))
+		if err != nil {
+			return nil, fmt.Errorf("cannot get integration: %w", err)
+		}
+		return observed, nil
+	}
+
+	source, dest, err := p.serviceNames(ctx, integration.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	services, err := p.exo.ListDBAASServices(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list services: %w", err)
+	}
+	for _, svc := range services.DBAASServices {
+		if string(svc.Name) == source {
+			return matchIntegration(svc.Integrations, integration.Spec.ForProvider.IntegrationType, source, dest), nil
+		}
+	}
+	return nil, nil
+}
+
+// matchIntegration returns the integration with the same type, source and destination.
+func matchIntegration(integrations []exoscalesdk.DBAASIntegration, integrationType, source, dest string) *exoscalesdk.DBAASIntegration {
+	for _, i := range integrations {
+		if i.Type == integrationType && i.Source == source && i.Dest == dest {
+			return &i
+		}
+	}
+	return nil
+}
+
+// isUpToDate returns true if every setting in the spec has the same value in the observed settings.
+// Settings not given in the spec are managed by the provider and ignored.
+func isUpToDate(spec, observed runtime.RawExtension) bool {
+	desired, err := mapper.ToMap(spec)
+	if err != nil {
+		return false
+	}
+	actual, err := mapper.ToMap(observed)
+	if err != nil {
+		return false
+	}
+	for k, v := range desired {
+		if !reflect.DeepEqual(v, actual[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+func mapObservation(in *exoscalesdk.DBAASIntegration) (exoscalev1.DBaaSIntegrationObservation, error) {
+	settings, err := json.Marshal(in.Settings)
+	if err != nil {
+		return exoscalev1.DBaaSIntegrationObservation{}, fmt.Errorf("cannot parse integration settings: %w", err)
+	}
+	return exoscalev1.DBaaSIntegrationObservation{
+		ID:                 string(in.ID),
+		Type:               in.Type,
+		SourceService:      in.Source,
+		DestinationService: in.Dest,
+		Active:             ptr.Deref(in.ISActive, false),
+		Enabled:            ptr.Deref(in.ISEnabled, false),
+		Status:             in.Status,
+		Settings:           runtime.RawExtension{Raw: settings},
+	}, nil
+}
@@ -0,0 +1,62 @@
+package dbaasintegrationcontroller
+
+import (
+	"testing"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestMatchIntegration(t *testing.T) {
+	integrations := []exoscalesdk.DBAASIntegration{
+		{ID: "1", Type: "metrics", Source: "pg", Dest: "grafana"},
+		{ID: "2", Type: "logs", Source: "pg", Dest: "opensearch"},
+		{ID: "3", Type: "metrics", Source: "pg", Dest: "other-grafana"},
+	}
+
+	tests := map[string]struct {
+		integrationType, source, dest string
+		expectedID                    exoscalesdk.UUID
+	}{
+		"Metrics":       {integrationType: "metrics", source: "pg", dest: "grafana", expectedID: "1"},
+		"Logs":          {integrationType: "logs", source: "pg", dest: "opensearch", expectedID: "2"},
+		"OtherDest":     {integrationType: "metrics", source: "pg", dest: "other-grafana", expectedID: "3"},
+		"WrongType":     {integrationType: "datasource", source: "pg", dest: "grafana"},
+		"WrongSource":   {integrationType: "metrics", source: "mysql", dest: "grafana"},
+		"UnknownTarget": {integrationType: "metrics", source: "pg", dest: "unknown"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			res := matchIntegration(integrations, tc.integrationType, tc.source, tc.dest)
+			if tc.expectedID == "" {
+				assert.Nil(t, res)
+				return
+			}
+			if assert.NotNil(t, res) {
+				assert.Equal(t, tc.expectedID, res.ID)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := runtime.RawExtension{Raw: []byte(`{"datasource_name":"metrics","retention_days":30,"nested":{"a":1}}`)}
+
+	tests := map[string]struct {
+		spec     runtime.RawExtension
+		expected bool
+	}{
+		"Empty":         {spec: runtime.RawExtension{}, expected: true},
+		"Subset":        {spec: runtime.RawExtension{Raw: []byte(`{"retention_days":30}`)}, expected: true},
+		"Nested":        {spec: runtime.RawExtension{Raw: []byte(`{"nested":{"a":1}}`)}, expected: true},
+		"DifferentVal":  {spec: runtime.RawExtension{Raw: []byte(`{"retention_days":7}`)}, expected: false},
+		"DifferentNest": {spec: runtime.RawExtension{Raw: []byte(`{"nested":{"a":2}}`)}, expected: false},
+		"Missing":       {spec: runtime.RawExtension{Raw: []byte(`{"unknown":true}`)}, expected: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isUpToDate(tc.spec, observed))
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package dbaasintegrationcontroller
+
+import (
+	"context"
+	"fmt"
+
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// dbaasService is a DBaaS managed resource that can be part of an integration.
+type dbaasService interface {
+	client.Object
+	GetInstanceName() string
+}
+
+// newService returns an empty managed resource of the given kind.
+func newService(kind string) (dbaasService, error) {
+	switch kind {
+	case exoscalev1.PostgreSQLKind:
+		return &exoscalev1.PostgreSQL{}, nil
+	case exoscalev1.MySQLKind:
+		return &exoscalev1.MySQL{}, nil
+	case exoscalev1.OpenSearchKind:
+		return &exoscalev1.OpenSearch{}, nil
+	case exoscalev1.GrafanaKind:
+		return &exoscalev1.Grafana{}, nil
+	}
+	return nil, fmt.Errorf("unsupported service kind %q", kind)
+}
+
+// serviceName returns the name of the Exoscale service of the referenced managed resource.
+func (p *pipeline) serviceName(ctx context.Context, ref exoscalev1.DBaaSServiceReference) (string, error) {
+	svc, err := newService(ref.Kind)
+	if err != nil {
+		return "", err
+	}
+	err = p.kube.Get(ctx, client.ObjectKey{Name: ref.Name}, svc)
+	if err != nil {
+		return "", fmt.Errorf("cannot get %s %q: %w", ref.Kind, ref.Name, err)
+	}
+	return svc.GetInstanceName(), nil
+}
+
+// serviceNames returns the names of the source and destination services of the integration.
+func (p *pipeline) serviceNames(ctx context.Context, params exoscalev1.DBaaSIntegrationParameters) (string, string, error) {
+	source, err := p.serviceName(ctx, params.Source)
+	if err != nil {
+		return "", "", err
+	}
+	dest, err := p.serviceName(ctx, params.Destination)
+	if err != nil {
+		return "", "", err
+	}
+	return source, dest, nil
+}
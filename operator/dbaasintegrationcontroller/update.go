@@ -0,0 +1,36 @@
+package dbaasintegrationcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/mapper"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+// Only the settings of an integration can be changed after creation.
+func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Updating resource")
+
+	integration := mg.(*exoscalev1.DBaaSIntegration)
+
+	settings, err := mapper.ToMap(integration.Spec.ForProvider.Settings)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot map integration settings")
+	}
+
+	resp, err := p.exo.UpdateDBAASIntegration(ctx, exoscalesdk.UUID(integration.Status.AtProvider.ID), exoscalesdk.UpdateDBAASIntegrationRequest{
+		Settings: settings,
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update integration")
+	}
+	log.V(1).Info("Response", "message", resp.Message)
+	return managed.ExternalUpdate{}, nil
+}
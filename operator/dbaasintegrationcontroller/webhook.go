@@ -0,0 +1,76 @@
+package dbaasintegrationcontroller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/webhook"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Validator validates admission requests.
+type Validator struct {
+	log logr.Logger
+}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	integration, ok := obj.(*exoscalev1.DBaaSIntegration)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for dbaas integration webhook", obj)
+	}
+	v.log.V(1).Info("Validate create", "name", integration.Name)
+
+	return nil, validateSpec(integration.Spec.ForProvider)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	newIntegration, ok := newObj.(*exoscalev1.DBaaSIntegration)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for dbaas integration webhook", newObj)
+	}
+	oldIntegration, ok := oldObj.(*exoscalev1.DBaaSIntegration)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for dbaas integration webhook", oldObj)
+	}
+	v.log.V(1).Info("Validate update", "name", newIntegration.Name)
+
+	err := validateSpec(newIntegration.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	return nil, validateImmutable(oldIntegration.Spec.ForProvider, newIntegration.Spec.ForProvider)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate delete (noop)")
+	return nil, nil
+}
+
+func validateSpec(params exoscalev1.DBaaSIntegrationParameters) error {
+	if params.Source == params.Destination {
+		return fmt.Errorf("source and destination cannot reference the same service")
+	}
+	return webhook.ValidateRawExtension(params.Settings)
+}
+
+func validateImmutable(oldParams, newParams exoscalev1.DBaaSIntegrationParameters) error {
+	if oldParams.Zone != newParams.Zone {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.Zone, newParams.Zone)
+	}
+	if oldParams.IntegrationType != newParams.IntegrationType {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.IntegrationType, newParams.IntegrationType)
+	}
+	if oldParams.Source != newParams.Source {
+		return fmt.Errorf("field is immutable: %s/%s (old), %s/%s (changed)", oldParams.Source.Kind, oldParams.Source.Name, newParams.Source.Kind, newParams.Source.Name)
+	}
+	if oldParams.Destination != newParams.Destination {
+		return fmt.Errorf("field is immutable: %s/%s (old), %s/%s (changed)", oldParams.Destination.Kind, oldParams.Destination.Name, newParams.Destination.Kind, newParams.Destination.Name)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+// Package externalendpoint attaches DBaaS services to DBaaSExternalEndpoints.
+package externalendpoint
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/mapper"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Client is the part of the Exoscale client needed to manage attachments.
+type Client interface {
+	ListDBAASExternalIntegrations(ctx context.Context, serviceName string) (*exoscalesdk.ListDBAASExternalIntegrationsResponse, error)
+	AttachDBAASServiceToEndpoint(ctx context.Context, sourceServiceName string, req exoscalesdk.AttachDBAASServiceToEndpointRequest) (*exoscalesdk.Operation, error)
+	DetachDBAASServiceFromEndpoint(ctx context.Context, sourceServiceName string, req exoscalesdk.DetachDBAASServiceFromEndpointRequest) (*exoscalesdk.Operation, error)
+}
+
+// Endpoint is an external endpoint a service should be attached to.
+type Endpoint struct {
+	ID   string
+	Type string
+}
+
+// Observe returns the external endpoints the given service is attached to
+// and whether they match the given attachments.
+// Attachments are not managed if nil is given, hence they're not observed and always up-to-date.
+// Endpoints that can't be resolved don't fail the observation, they're reported in the
+// ExternalEndpointsResolved condition of the given status instead.
+func Observe(ctx context.Context, kube client.Client, c Client, status *xpv1.ConditionedStatus, serviceName string, attachments []exoscalev1.ExternalEndpointAttachment) ([]exoscalev1.ExternalEndpointObservation, bool, error) {
+	if attachments == nil {
+		mapper.RemoveStatusCondition(status, exoscalev1.TypeExternalEndpointsResolved)
+		return nil, true, nil
+	}
+	observed, err := observe(ctx, c, serviceName)
+	if err != nil {
+		return nil, false, err
+	}
+	desired, err := resolve(ctx, kube, attachments)
+	if err != nil {
+		status.SetConditions(exoscalev1.EndpointsUnresolved(err))
+		return observed, false, nil
+	}
+	status.SetConditions(exoscalev1.EndpointsResolved())
+	return observed, isUpToDate(desired, observed), nil
+}
+
+// Update attaches the service to the missing endpoints and detaches it from the endpoints no longer desired.
+// Attachments are not managed if nil is given.
+func Update(ctx context.Context, kube client.Client, c Client, serviceName string, attachments []exoscalev1.ExternalEndpointAttachment, observed []exoscalev1.ExternalEndpointObservation) error {
+	if attachments == nil {
+		return nil
+	}
+	desired, err := resolve(ctx, kube, attachments)
+	if err != nil {
+		return err
+	}
+	return sync(ctx, c, serviceName, desired, observed)
+}
+
+// observe returns the external endpoints the given service is attached to.
+func observe(ctx context.Context, c Client, serviceName string) ([]exoscalev1.ExternalEndpointObservation, error) {
+	resp, err := c.ListDBAASExternalIntegrations(ctx, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list external endpoints: %w", err)
+	}
+	observed := make([]exoscalev1.ExternalEndpointObservation, 0, len(resp.ExternalIntegrations))
+	for _, i := range resp.ExternalIntegrations {
+		observed = append(observed, exoscalev1.ExternalEndpointObservation{
+			IntegrationID: string(i.IntegrationID),
+			EndpointID:    i.DestEndpointID,
+			EndpointName:  i.DestEndpointName,
+			Type:          string(i.Type),
+			Status:        i.Status,
+		})
+	}
+	return observed, nil
+}
+
+// resolve returns the endpoints of the referenced DBaaSExternalEndpoints.
+// Returns an error if an endpoint doesn't exist yet.
+func resolve(ctx context.Context, kube client.Client, attachments []exoscalev1.ExternalEndpointAttachment) ([]Endpoint, error) {
+	endpoints := make([]Endpoint, 0, len(attachments))
+	for _, a := range attachments {
+		ep := &exoscalev1.DBaaSExternalEndpoint{}
+		err := kube.Get(ctx, client.ObjectKey{Name: a.Name}, ep)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get external endpoint %q: %w", a.Name, err)
+		}
+		if ep.Status.AtProvider.ID == "" {
+			return nil, fmt.Errorf("external endpoint %q is not ready", a.Name)
+		}
+		endpoints = append(endpoints, Endpoint{ID: ep.Status.AtProvider.ID, Type: ep.Spec.ForProvider.Type})
+	}
+	return endpoints, nil
+}
+
+// isUpToDate returns true if the service is attached to exactly the desired endpoints.
+func isUpToDate(desired []Endpoint, observed []exoscalev1.ExternalEndpointObservation) bool {
+	attach, detach := diff(desired, observed)
+	return len(attach) == 0 && len(detach) == 0
+}
+
+// sync attaches the service to the missing endpoints and detaches it from the endpoints no longer desired.
+func sync(ctx context.Context, c Client, serviceName string, desired []Endpoint, observed []exoscalev1.ExternalEndpointObservation) error {
+	attach, detach := diff(desired, observed)
+	for _, o := range detach {
+		_, err := c.DetachDBAASServiceFromEndpoint(ctx, serviceName, exoscalesdk.DetachDBAASServiceFromEndpointRequest{
+			IntegrationID: exoscalesdk.UUID(o.IntegrationID),
+		})
+		if err != nil {
+			return fmt.Errorf("cannot detach external endpoint %q: %w", o.EndpointName, err)
+		}
+	}
+	for _, e := range attach {
+		_, err := c.AttachDBAASServiceToEndpoint(ctx, serviceName, exoscalesdk.AttachDBAASServiceToEndpointRequest{
+			DestEndpointID: exoscalesdk.UUID(e.ID),
+			Type:           exoscalesdk.EnumExternalEndpointTypes(e.Type),
+		})
+		if err != nil {
+			return fmt.Errorf("cannot attach external endpoint %q: %w", e.ID, err)
+		}
+	}
+	return nil
+}
+
+// diff returns the endpoints to attach and the attachments to remove.
+func diff(desired []Endpoint, observed []exoscalev1.ExternalEndpointObservation) ([]Endpoint, []exoscalev1.ExternalEndpointObservation) {
+	wanted := make(map[string]bool, len(desired))
+	for _, e := range desired {
+		wanted[e.ID] = true
+	}
+	attached := make(map[string]bool, len(observed))
+	detach := []exoscalev1.ExternalEndpointObservation{}
+	for _, o := range observed {
+		if !wanted[o.EndpointID] || attached[o.EndpointID] {
+			detach = append(detach, o)
+			continue
+		}
+		attached[o.EndpointID] = true
+	}
+	attach := []Endpoint{}
+	for _, e := range desired {
+		if !attached[e.ID] {
+			attach = append(attach, e)
+			attached[e.ID] = true
+		}
+	}
+	return attach, detach
+}
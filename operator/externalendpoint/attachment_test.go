@@ -0,0 +1,150 @@
+package externalendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/mapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDiff(t *testing.T) {
+	prometheus := Endpoint{ID: "prom", Type: "prometheus"}
+	rsyslog := Endpoint{ID: "syslog", Type: "rsyslog"}
+	attachedPrometheus := exoscalev1.ExternalEndpointObservation{IntegrationID: "1", EndpointID: "prom", Type: "prometheus"}
+	attachedRsyslog := exoscalev1.ExternalEndpointObservation{IntegrationID: "2", EndpointID: "syslog", Type: "rsyslog"}
+
+	tests := map[string]struct {
+		desired        []Endpoint
+		observed       []exoscalev1.ExternalEndpointObservation
+		expectedAttach []Endpoint
+		expectedDetach []exoscalev1.ExternalEndpointObservation
+	}{
+		"Empty": {
+			expectedAttach: []Endpoint{},
+			expectedDetach: []exoscalev1.ExternalEndpointObservation{},
+		},
+		"UpToDate": {
+			desired:        []Endpoint{prometheus, rsyslog},
+			observed:       []exoscalev1.ExternalEndpointObservation{attachedRsyslog, attachedPrometheus},
+			expectedAttach: []Endpoint{},
+			expectedDetach: []exoscalev1.ExternalEndpointObservation{},
+		},
+		"Attach": {
+			desired:        []Endpoint{prometheus, rsyslog},
+			observed:       []exoscalev1.ExternalEndpointObservation{attachedPrometheus},
+			expectedAttach: []Endpoint{rsyslog},
+			expectedDetach: []exoscalev1.ExternalEndpointObservation{},
+		},
+		"Detach": {
+			desired:        []Endpoint{prometheus},
+			observed:       []exoscalev1.ExternalEndpointObservation{attachedPrometheus, attachedRsyslog},
+			expectedAttach: []Endpoint{},
+			expectedDetach: []exoscalev1.ExternalEndpointObservation{attachedRsyslog},
+		},
+		"DuplicateDesired": {
+			desired:        []Endpoint{prometheus, prometheus},
+			expectedAttach: []Endpoint{prometheus},
+			expectedDetach: []exoscalev1.ExternalEndpointObservation{},
+		},
+		"DuplicateAttached": {
+			desired:        []Endpoint{prometheus},
+			observed:       []exoscalev1.ExternalEndpointObservation{attachedPrometheus, {IntegrationID: "3", EndpointID: "prom"}},
+			expectedAttach: []Endpoint{},
+			expectedDetach: []exoscalev1.ExternalEndpointObservation{{IntegrationID: "3", EndpointID: "prom"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			attach, detach := diff(tc.desired, tc.observed)
+			assert.Equal(t, tc.expectedAttach, attach)
+			assert.Equal(t, tc.expectedDetach, detach)
+			assert.Equal(t, len(attach) == 0 && len(detach) == 0, isUpToDate(tc.desired, tc.observed))
+		})
+	}
+}
+
+// fakeClient lists the given integrations, other calls to the Exoscale API panic.
+type fakeClient struct {
+	Client
+	attached  []exoscalesdk.DBAASExternalIntegration
+	listCalls int
+}
+
+func (c *fakeClient) ListDBAASExternalIntegrations(_ context.Context, _ string) (*exoscalesdk.ListDBAASExternalIntegrationsResponse, error) {
+	c.listCalls++
+	return &exoscalesdk.ListDBAASExternalIntegrationsResponse{ExternalIntegrations: c.attached}, nil
+}
+
+func TestObserve(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, exoscalev1.SchemeBuilder.AddToScheme(scheme))
+	ready := &exoscalev1.DBaaSExternalEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "ready"},
+		Spec:       exoscalev1.DBaaSExternalEndpointSpec{ForProvider: exoscalev1.DBaaSExternalEndpointParameters{Type: "prometheus"}},
+		Status:     exoscalev1.DBaaSExternalEndpointStatus{AtProvider: exoscalev1.DBaaSExternalEndpointObservation{ID: "prom"}},
+	}
+	pending := &exoscalev1.DBaaSExternalEndpoint{ObjectMeta: metav1.ObjectMeta{Name: "pending"}}
+	kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ready, pending).WithStatusSubresource(ready, pending).Build()
+	attached := []exoscalesdk.DBAASExternalIntegration{{IntegrationID: "1", DestEndpointID: "prom", Type: "prometheus"}}
+
+	tests := map[string]struct {
+		attachments       []exoscalev1.ExternalEndpointAttachment
+		expectedUpToDate  bool
+		expectedListCalls int
+		expectedCondition *xpv1.ConditionReason
+	}{
+		"NotManaged": {
+			expectedUpToDate: true,
+		},
+		"UpToDate": {
+			attachments:       []exoscalev1.ExternalEndpointAttachment{{Name: "ready"}},
+			expectedUpToDate:  true,
+			expectedListCalls: 1,
+			expectedCondition: ptr.To(exoscalev1.ReasonEndpointsResolved),
+		},
+		"Detach": {
+			attachments:       []exoscalev1.ExternalEndpointAttachment{},
+			expectedListCalls: 1,
+			expectedCondition: ptr.To(exoscalev1.ReasonEndpointsResolved),
+		},
+		"NotReady": {
+			attachments:       []exoscalev1.ExternalEndpointAttachment{{Name: "ready"}, {Name: "pending"}},
+			expectedListCalls: 1,
+			expectedCondition: ptr.To(exoscalev1.ReasonEndpointsUnresolved),
+		},
+		"NotFound": {
+			attachments:       []exoscalev1.ExternalEndpointAttachment{{Name: "missing"}},
+			expectedListCalls: 1,
+			expectedCondition: ptr.To(exoscalev1.ReasonEndpointsUnresolved),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &fakeClient{attached: attached}
+			status := &xpv1.ConditionedStatus{}
+			status.SetConditions(exoscalev1.EndpointsUnresolved(errors.New("stale")))
+
+			_, upToDate, err := Observe(context.TODO(), kube, c, status, "service", tc.attachments)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedUpToDate, upToDate)
+			assert.Equal(t, tc.expectedListCalls, c.listCalls)
+			condition := mapper.FindStatusCondition(status.Conditions, exoscalev1.TypeExternalEndpointsResolved)
+			if tc.expectedCondition == nil {
+				assert.Nil(t, condition)
+				return
+			}
+			require.NotNil(t, condition)
+			assert.Equal(t, *tc.expectedCondition, condition.Reason)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package grafanacontroller
+
+import "context"
+
+func (p *pipeline) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,192 @@
+package grafanacontroller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/mapper"
+	"github.com/vshn/provider-exoscale/operator/notification"
+	"github.com/vshn/provider-exoscale/operator/trigger"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/go-logr/logr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+func (p pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("observing resource")
+
+	grafanaInstance := mg.(*exoscalev1.Grafana)
+
+	grafana, err := p.exo.GetDBAASServiceGrafana(ctx, grafanaInstance.GetInstanceName())
+	if err != nil {
+		if errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, fmt.Errorf("unable to observe instance: %w", err)
+	}
+
+	log.V(1).Info("retrieved instance", "state", grafana.State)
+
+	previousTriggers := grafanaInstance.Status.AtProvider.Triggers
+	previousNotifications := grafanaInstance.Status.AtProvider.Notifications
+	grafanaInstance.Status.AtProvider, err = mapObservation(grafana)
+	if err != nil {
+		log.Error(err, "unable to fully map observation, ignoring.")
+	}
+	notification.RecordWarnings(p.recorder, grafanaInstance, previousNotifications, grafanaInstance.Status.AtProvider.Notifications)
+
+	var state exoscalesdk.EnumServiceState
+	if grafana.State != "" {
+		state = grafana.State
+	}
+	switch state {
+	case exoscalesdk.EnumServiceStateRunning:
+		grafanaInstance.SetConditions(exoscalev1.Running())
+	case exoscalesdk.EnumServiceStateRebuilding:
+		grafanaInstance.SetConditions(exoscalev1.Rebuilding())
+	case exoscalesdk.EnumServiceStatePoweroff:
+		grafanaInstance.SetConditions(exoscalev1.PoweredOff())
+	case exoscalesdk.EnumServiceStateRebalancing:
+		grafanaInstance.SetConditions(exoscalev1.Rebalancing())
+	default:
+		log.V(2).Info("ignoring unknown instance state", "state", state)
+	}
+
+	rp, err := mapParameters(grafana, grafanaInstance.Spec.ForProvider.Zone)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cd, err := connectionDetails(ctx, grafana, p.exo)
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("unable to parse connection details: %w", err)
+	}
+
+	currentParams := &grafanaInstance.Spec.ForProvider
+	if currentParams.SettingsPolicy != exoscalev1.SettingsPolicyManagedKeysOnly {
+		// Unset settings are expected to have their default value.
+		currentParams, err = setSettingsDefaults(ctx, *p.exo, &grafanaInstance.Spec.ForProvider)
+		if err != nil {
+			log.Error(err, "unable to set grafana settings schema")
+			currentParams = &grafanaInstance.Spec.ForProvider
+		}
+	}
+
+	grafanaInstance.Status.AtProvider.Triggers = previousTriggers
+
+	observation := managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isUpToDate(currentParams, rp, log) && trigger.IsUpToDate(grafanaInstance, previousTriggers),
+		ResourceLateInitialized: false,
+		ConnectionDetails:       cd,
+	}
+
+	return observation, nil
+}
+
+func isUpToDate(current, external *exoscalev1.GrafanaParameters, log logr.Logger) bool {
+	if external == nil {
+		return false
+	}
+	extIPFilter := []string(external.IPFilter)
+	checks := map[string]bool{
+		"IPFilter":              mapper.IsSameIPFilter(current.IPFilter, &extIPFilter),
+		"Maintenance":           current.Maintenance.Equals(external.Maintenance),
+		"Size":                  current.Size.Equals(external.Size),
+		"TerminationProtection": current.TerminationProtection == external.TerminationProtection,
+		"GrafanaSettings":       mapper.CompareSettingsWithPolicy(current.SettingsPolicy, current.GrafanaSettings, external.GrafanaSettings),
+	}
+	ok := true
+	for _, v := range checks {
+		if !v {
+			log.V(2).Info("instance not up-to-date", "check", v)
+			ok = false
+		}
+	}
+	return ok
+}
+
+func connectionDetails(ctx context.Context, in *exoscalesdk.DBAASServiceGrafana, client *exoscalesdk.Client) (managed.ConnectionDetails, error) {
+	if in.ConnectionInfo == nil || in.ConnectionInfo.URI == "" {
+		return map[string][]byte{}, nil
+	}
+	password, err := client.RevealDBAASGrafanaUserPassword(ctx, string(in.Name), in.ConnectionInfo.Username)
+	if err != nil {
+		return nil, fmt.Errorf("cannot reveal password for Grafana instance: %w", err)
+	}
+	return adminDetails(in.ConnectionInfo, password.Password)
+}
+
+// adminDetails returns the connection details of the Grafana admin user.
+func adminDetails(info *exoscalesdk.DBAASServiceGrafanaConnectionInfo, password string) (managed.ConnectionDetails, error) {
+	parsed, err := url.Parse(info.URI)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse connection URI: %w", err)
+	}
+	return map[string][]byte{
+		"GRAFANA_HOST":     []byte(parsed.Hostname()),
+		"GRAFANA_PORT":     []byte(parsed.Port()),
+		"GRAFANA_USERNAME": []byte(info.Username),
+		"GRAFANA_PASSWORD": []byte(password),
+		"GRAFANA_URL":      []byte(info.URI),
+	}, nil
+}
+
+func mapObservation(instance *exoscalesdk.DBAASServiceGrafana) (exoscalev1.GrafanaObservation, error) {
+	jsonSettings, err := json.Marshal(instance.GrafanaSettings)
+	if err != nil {
+		return exoscalev1.GrafanaObservation{}, fmt.Errorf("error parsing GrafanaSettings")
+	}
+
+	settings := runtime.RawExtension{Raw: jsonSettings}
+
+	observation := exoscalev1.GrafanaObservation{
+		Version:    instance.Version,
+		NodeStates: mapper.ToNodeStates(&instance.NodeStates),
+	}
+
+	observation.GrafanaSettings = settings
+
+	notifications, err := mapper.ToNotifications(instance.Notifications)
+	if err != nil {
+		return observation, fmt.Errorf("notifications: %w", err)
+	}
+	observation.Notifications = notifications
+
+	return observation, nil
+}
+
+func mapParameters(in *exoscalesdk.DBAASServiceGrafana, zone exoscalev1.Zone) (*exoscalev1.GrafanaParameters, error) {
+	jsonSettings, err := json.Marshal(in.GrafanaSettings)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse grafanaInstance settings: %w", err)
+	}
+
+	settings := runtime.RawExtension{Raw: jsonSettings}
+
+	return &exoscalev1.GrafanaParameters{
+		Maintenance: exoscalev1.MaintenanceSpec{
+			DayOfWeek: in.Maintenance.Dow,
+			TimeOfDay: exoscalev1.TimeOfDay(in.Maintenance.Time),
+		},
+		Zone: zone,
+		DBaaSParameters: exoscalev1.DBaaSParameters{
+			TerminationProtection: ptr.Deref(in.TerminationProtection, false),
+			Size: exoscalev1.SizeSpec{
+				Plan: in.Plan,
+			},
+			IPFilter: in.IPFilter,
+		},
+		GrafanaSettings: settings,
+	}, nil
+}
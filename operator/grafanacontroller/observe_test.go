@@ -0,0 +1,25 @@
+package grafanacontroller
+
+import (
+	"testing"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminDetails(t *testing.T) {
+	info := &exoscalesdk.DBAASServiceGrafanaConnectionInfo{
+		URI:      "https://grafana-local-dev-exoscale.aivencloud.com:443",
+		Username: "avnadmin",
+	}
+
+	details, err := adminDetails(info, "secret")
+	require.NoError(t, err)
+
+	assert.Equal(t, "grafana-local-dev-exoscale.aivencloud.com", string(details["GRAFANA_HOST"]))
+	assert.Equal(t, "443", string(details["GRAFANA_PORT"]))
+	assert.Equal(t, "avnadmin", string(details["GRAFANA_USERNAME"]))
+	assert.Equal(t, "secret", string(details["GRAFANA_PASSWORD"]))
+	assert.Equal(t, "https://grafana-local-dev-exoscale.aivencloud.com:443", string(details["GRAFANA_URL"]))
+}
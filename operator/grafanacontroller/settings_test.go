@@ -0,0 +1,72 @@
+package grafanacontroller
+
+import (
+	"context"
+	"testing"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/mapper"
+)
+
+type fakeSettingsFetcher struct{}
+
+func (fakeSettingsFetcher) GetDBAASSettingsGrafana(ctx context.Context) (*exoscalesdk.GetDBAASSettingsGrafanaResponse, error) {
+	return &exoscalesdk.GetDBAASSettingsGrafanaResponse{
+		Settings: &grafanaSettings,
+	}, nil
+}
+
+func TestDefaultSettings(t *testing.T) {
+	foundSettings := map[string]interface{}{
+		"alerting_enabled":            false,
+		"dashboards_versions_to_keep": 5,
+	}
+	foundSettingRaw, err := mapper.ToRawExtension(&foundSettings)
+	require.NoError(t, err, "failed to parse input setting")
+	found := exoscalev1.GrafanaParameters{
+		Maintenance: exoscalev1.MaintenanceSpec{},
+		Zone:        "gva-2",
+		DBaaSParameters: exoscalev1.DBaaSParameters{
+			TerminationProtection: false,
+			Size: exoscalev1.SizeSpec{
+				Plan: "hobbyist-2",
+			},
+		},
+		GrafanaSettings: foundSettingRaw,
+	}
+
+	withDefaults, err := setSettingsDefaults(context.Background(), fakeSettingsFetcher{}, &found)
+	require.NoError(t, err, "failed to set defaults")
+	settingsWithDefaults, err := mapper.ToMap(withDefaults.GrafanaSettings)
+	require.NoError(t, err, "failed to parse set defaults")
+	assert.EqualValues(t, false, settingsWithDefaults["alerting_enabled"])
+	assert.EqualValues(t, 5, settingsWithDefaults["dashboards_versions_to_keep"])
+	assert.EqualValues(t, "Viewer", settingsWithDefaults["user_auto_assign_org_role"])
+	assert.Len(t, settingsWithDefaults, 3)
+}
+
+var grafanaSettings = exoscalesdk.GetDBAASSettingsGrafanaResponseSettings{
+	Grafana: &exoscalesdk.GetDBAASSettingsGrafanaResponseSettingsGrafana{
+		Properties: map[string]any{
+			"alerting_enabled": map[string]any{
+				"type":  "boolean",
+				"title": "Enable or disable Grafana legacy alerting functionality",
+			},
+			"dashboards_versions_to_keep": map[string]any{
+				"type":    "integer",
+				"minimum": 1,
+				"maximum": 100,
+				"title":   "Dashboard versions to keep per dashboard",
+			},
+			"user_auto_assign_org_role": map[string]any{
+				"enum":    []string{"Viewer", "Admin", "Editor"},
+				"type":    "string",
+				"default": "Viewer",
+				"title":   "Set role for new signups",
+			},
+		},
+	},
+}
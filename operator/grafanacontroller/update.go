@@ -0,0 +1,53 @@
+package grafanacontroller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/trigger"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+func (p pipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("updating resource")
+
+	grafanaInstance := mg.(*exoscalev1.Grafana)
+
+	grafanaInstance.Status.AtProvider.Triggers = trigger.Handle(ctx, p.recorder, grafanaInstance, grafanaInstance.GetInstanceName(), trigger.Actions{
+		trigger.MaintenanceAnnotation: p.exo.StartDBAASGrafanaMaintenance,
+	}, grafanaInstance.Status.AtProvider.Triggers)
+
+	spec := grafanaInstance.Spec.ForProvider
+	ipFilter := []string(spec.IPFilter)
+	settings := exoscalesdk.JSONSchemaGrafana{}
+	if len(spec.GrafanaSettings.Raw) != 0 {
+		err := json.Unmarshal(spec.GrafanaSettings.Raw, &settings)
+		if err != nil {
+			return managed.ExternalUpdate{}, fmt.Errorf("cannot map grafanaInstance settings: %w", err)
+		}
+	}
+
+	body := exoscalesdk.UpdateDBAASServiceGrafanaRequest{
+		IPFilter: ipFilter,
+		Maintenance: &exoscalesdk.UpdateDBAASServiceGrafanaRequestMaintenance{
+			Dow:  exoscalesdk.UpdateDBAASServiceGrafanaRequestMaintenanceDow(spec.Maintenance.DayOfWeek),
+			Time: spec.Maintenance.TimeOfDay.String(),
+		},
+		Plan:                  spec.Size.Plan,
+		GrafanaSettings:       &settings,
+		TerminationProtection: &spec.TerminationProtection,
+	}
+	resp, err := p.exo.UpdateDBAASServiceGrafana(ctx, grafanaInstance.GetInstanceName(), body)
+	if err != nil {
+		return managed.ExternalUpdate{}, fmt.Errorf("unable to create instance: %w", err)
+	}
+	log.V(1).Info("response", "message", string(resp.Message))
+	return managed.ExternalUpdate{}, nil
+}
@@ -0,0 +1,32 @@
+package kafkaaclcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *pipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	acl := mg.(*exoscalev1.KafkaACL)
+	spec := acl.Spec.ForProvider
+
+	resp, err := p.exo.CreateDBAASKafkaTopicAclConfig(ctx, spec.InstanceName, exoscalesdk.DBAASKafkaTopicAclEntry{
+		Username:   spec.Username,
+		Topic:      spec.Topic,
+		Permission: exoscalesdk.DBAASKafkaTopicAclEntryPermission(spec.Permission),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create ACL")
+	}
+	log.V(1).Info("Response", "message", resp.Message)
+	return managed.ExternalCreation{}, nil
+}
@@ -0,0 +1,63 @@
+package kafkaaclcontroller
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+// Observe implements managed.ExternalClient.
+// ACL entries can't be changed, so an existing entry is always up-to-date.
+func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	acl := mg.(*exoscalev1.KafkaACL)
+
+	entry, err := p.findEntry(ctx, acl.Spec.ForProvider)
+	if err != nil {
+		if errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, err
+	}
+	if entry == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	acl.Status.AtProvider.ID = string(entry.ID)
+
+	acl.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// findEntry returns the topic ACL entry of the instance matching the given parameters.
+// Returns nil if there is no such entry.
+func (p *pipeline) findEntry(ctx context.Context, params exoscalev1.KafkaACLParameters) (*exoscalesdk.DBAASKafkaTopicAclEntry, error) {
+	acls, err := p.exo.GetDBAASKafkaAclConfig(ctx, params.InstanceName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get ACL config of Kafka instance: %w", err)
+	}
+	return matchEntry(acls.TopicAcl, params), nil
+}
+
+// matchEntry returns the entry with the same user, topic and permission.
+// Entries have no name, so these three fields identify an entry.
+func matchEntry(entries []exoscalesdk.DBAASKafkaTopicAclEntry, params exoscalev1.KafkaACLParameters) *exoscalesdk.DBAASKafkaTopicAclEntry {
+	for _, e := range entries {
+		if e.Username == params.Username && e.Topic == params.Topic && string(e.Permission) == params.Permission {
+			return &e
+		}
+	}
+	return nil
+}
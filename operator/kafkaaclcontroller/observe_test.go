@@ -0,0 +1,49 @@
+package kafkaaclcontroller
+
+import (
+	"testing"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+func TestMatchEntry(t *testing.T) {
+	entries := []exoscalesdk.DBAASKafkaTopicAclEntry{
+		{ID: "default", Username: "*", Topic: "*", Permission: "admin"},
+		{ID: "acl-1", Username: "app", Topic: "orders-*", Permission: "read"},
+		{ID: "acl-2", Username: "app", Topic: "orders-*", Permission: "write"},
+	}
+
+	tests := map[string]struct {
+		params     exoscalev1.KafkaACLParameters
+		expectedID string
+	}{
+		"Read": {
+			params:     exoscalev1.KafkaACLParameters{Username: "app", Topic: "orders-*", Permission: "read"},
+			expectedID: "acl-1",
+		},
+		"Write": {
+			params:     exoscalev1.KafkaACLParameters{Username: "app", Topic: "orders-*", Permission: "write"},
+			expectedID: "acl-2",
+		},
+		"DifferentPermission": {
+			params: exoscalev1.KafkaACLParameters{Username: "app", Topic: "orders-*", Permission: "readwrite"},
+		},
+		"DifferentTopic": {
+			params: exoscalev1.KafkaACLParameters{Username: "app", Topic: "orders", Permission: "read"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			entry := matchEntry(entries, tc.params)
+			if tc.expectedID == "" {
+				assert.Nil(t, entry)
+				return
+			}
+			require.NotNil(t, entry)
+			assert.Equal(t, tc.expectedID, string(entry.ID))
+		})
+	}
+}
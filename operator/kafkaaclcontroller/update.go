@@ -0,0 +1,17 @@
+package kafkaaclcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+// An ACL entry cannot be changed after creation, hence this is a noop.
+func (p *pipeline) Update(ctx context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Updating resource (noop)")
+	return managed.ExternalUpdate{}, nil
+}
@@ -0,0 +1,81 @@
+package kafkaaclcontroller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Validator validates admission requests.
+type Validator struct {
+	log logr.Logger
+}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	acl, ok := obj.(*exoscalev1.KafkaACL)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for kafka acl webhook", obj)
+	}
+	v.log.V(1).Info("Validate create", "name", acl.Name)
+
+	return nil, validateSpec(acl.Spec.ForProvider)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	newACL, ok := newObj.(*exoscalev1.KafkaACL)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for kafka acl webhook", newObj)
+	}
+	oldACL, ok := oldObj.(*exoscalev1.KafkaACL)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for kafka acl webhook", oldObj)
+	}
+	v.log.V(1).Info("Validate update", "name", newACL.Name)
+
+	err := validateSpec(newACL.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	return nil, validateImmutable(oldACL.Spec.ForProvider, newACL.Spec.ForProvider)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate delete (noop)")
+	return nil, nil
+}
+
+func validateSpec(params exoscalev1.KafkaACLParameters) error {
+	if params.InstanceName == "" && params.InstanceRef == nil && params.InstanceSelector == nil {
+		return fmt.Errorf("one of instanceName, instanceRef or instanceSelector is required")
+	}
+	if params.Username == "" && params.UserRef == nil && params.UserSelector == nil {
+		return fmt.Errorf("one of username, userRef or userSelector is required")
+	}
+	return nil
+}
+
+func validateImmutable(oldParams, newParams exoscalev1.KafkaACLParameters) error {
+	if oldParams.Zone != newParams.Zone {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.Zone, newParams.Zone)
+	}
+	if oldParams.InstanceName != "" && oldParams.InstanceName != newParams.InstanceName {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.InstanceName, newParams.InstanceName)
+	}
+	if oldParams.Username != "" && oldParams.Username != newParams.Username {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.Username, newParams.Username)
+	}
+	if oldParams.Topic != newParams.Topic {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.Topic, newParams.Topic)
+	}
+	if oldParams.Permission != newParams.Permission {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.Permission, newParams.Permission)
+	}
+	return nil
+}
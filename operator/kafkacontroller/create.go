@@ -41,6 +41,20 @@ func (p *pipeline) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			return managed.ExternalCreation{}, fmt.Errorf("invalid kafka rest settings: %w", err)
 		}
 	}
+	connectSettings := exoscalesdk.JSONSchemaKafkaConnect{}
+	if len(spec.KafkaConnectSettings.Raw) != 0 {
+		err := json.Unmarshal(spec.KafkaConnectSettings.Raw, &connectSettings)
+		if err != nil {
+			return managed.ExternalCreation{}, fmt.Errorf("invalid kafka connect settings: %w", err)
+		}
+	}
+	registrySettings := exoscalesdk.JSONSchemaSchemaRegistry{}
+	if len(spec.SchemaRegistrySettings.Raw) != 0 {
+		err := json.Unmarshal(spec.SchemaRegistrySettings.Raw, &registrySettings)
+		if err != nil {
+			return managed.ExternalCreation{}, fmt.Errorf("invalid schema registry settings: %w", err)
+		}
+	}
 
 	body := exoscalesdk.CreateDBAASServiceKafkaRequest{
 		IPFilter:      ipFilter,
@@ -49,11 +63,15 @@ func (p *pipeline) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			Dow:  exoscalesdk.CreateDBAASServiceKafkaRequestMaintenanceDow(spec.Maintenance.DayOfWeek),
 			Time: spec.Maintenance.TimeOfDay.String(),
 		},
-		Plan:                  spec.Size.Plan,
-		Version:               spec.Version,
-		TerminationProtection: &spec.TerminationProtection,
-		KafkaRestEnabled:      &spec.KafkaRestEnabled,
-		KafkaRestSettings:     restSettings,
+		Plan:                   spec.Size.Plan,
+		Version:                spec.Version,
+		TerminationProtection:  &spec.TerminationProtection,
+		KafkaRestEnabled:       &spec.KafkaRestEnabled,
+		KafkaRestSettings:      restSettings,
+		KafkaConnectEnabled:    &spec.KafkaConnectEnabled,
+		KafkaConnectSettings:   connectSettings,
+		SchemaRegistryEnabled:  &spec.SchemaRegistryEnabled,
+		SchemaRegistrySettings: registrySettings,
 	}
 
 	resp, err := p.exo.CreateDBAASServiceKafka(ctx, instance.GetInstanceName(), body)
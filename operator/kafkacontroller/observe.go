@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
@@ -19,7 +20,10 @@ import (
 	controllerruntime "sigs.k8s.io/controller-runtime"
 
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/externalendpoint"
 	"github.com/vshn/provider-exoscale/operator/mapper"
+	"github.com/vshn/provider-exoscale/operator/notification"
+	"github.com/vshn/provider-exoscale/operator/trigger"
 )
 
 // Observe the external kafka instance.
@@ -42,16 +46,20 @@ func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, err
 	}
 
+	previousTriggers := instance.Status.AtProvider.Triggers
+	previousNotifications := instance.Status.AtProvider.Notifications
 	instance.Status.AtProvider, err = getObservation(res)
 	if err != nil {
 		log.Error(err, "failed to observe kafka instance")
 	}
+	notification.RecordWarnings(p.recorder, instance, previousNotifications, instance.Status.AtProvider.Notifications)
 
 	condition, err := getCondition(res)
 	if err != nil {
 		log.Error(err, "failed to update kafka condition")
 	}
 	instance.SetConditions(condition)
+	mapper.SetBackupCondition(&instance.Status.ConditionedStatus, instance.Status.AtProvider.LastBackupTime, res.CreatedAT, instance.Spec.ForProvider.MaxBackupAge, time.Now())
 
 	caCert, err := p.exo.GetDBAASCACertificate(ctx)
 	if err != nil {
@@ -63,17 +71,29 @@ func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, fmt.Errorf("failed to get kafka connection details: %w", err)
 	}
 
-	currentParams, err := setSettingsDefaults(ctx, *p.exo, &instance.Spec.ForProvider)
-	if err != nil {
-		log.Error(err, "unable to set kafka settings schema")
-		currentParams = &instance.Spec.ForProvider
+	currentParams := &instance.Spec.ForProvider
+	if currentParams.SettingsPolicy != exoscalev1.SettingsPolicyManagedKeysOnly {
+		// Unset settings are expected to have their default value.
+		currentParams, err = setSettingsDefaults(ctx, *p.exo, &instance.Spec.ForProvider)
+		if err != nil {
+			log.Error(err, "unable to set kafka settings schema")
+			currentParams = &instance.Spec.ForProvider
+		}
 	}
 
 	upToDate, diff := diffParameters(res, *currentParams)
 
+	endpoints, endpointsUpToDate, err := externalendpoint.Observe(ctx, p.kube, p.exo, &instance.Status.ConditionedStatus, instance.GetInstanceName(), instance.Spec.ForProvider.ExternalEndpoints)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	instance.Status.AtProvider.ExternalEndpoints = endpoints
+
+	instance.Status.AtProvider.Triggers = previousTriggers
+
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  upToDate,
+		ResourceUpToDate:  upToDate && endpointsUpToDate && trigger.IsUpToDate(instance, previousTriggers),
 		ConnectionDetails: connDetails,
 		Diff:              diff,
 	}, nil
@@ -102,13 +122,33 @@ func getObservation(external *exoscalesdk.DBAASServiceKafka) (exoscalev1.KafkaOb
 	}
 	restSettings := runtime.RawExtension{Raw: jsonRestSettings}
 
+	jsonConnectSettings, err := json.Marshal(external.KafkaConnectSettings)
+	if err != nil {
+		return exoscalev1.KafkaObservation{}, fmt.Errorf("error parsing kafka connect settings: %w", err)
+	}
+	connectSettings := runtime.RawExtension{Raw: jsonConnectSettings}
+
+	jsonRegistrySettings, err := json.Marshal(external.SchemaRegistrySettings)
+	if err != nil {
+		return exoscalev1.KafkaObservation{}, fmt.Errorf("error parsing schema registry settings: %w", err)
+	}
+	registrySettings := runtime.RawExtension{Raw: jsonRegistrySettings}
+
+	backups, lastBackupTime := mapper.ToBackups(external.Backups)
+
 	return exoscalev1.KafkaObservation{
-		Version:           external.Version,
-		KafkaSettings:     settings,
-		KafkaRestEnabled:  ptr.Deref(external.KafkaRestEnabled, false),
-		KafkaRestSettings: restSettings,
-		NodeStates:        nodeStates,
-		Notifications:     notifications,
+		Version:                external.Version,
+		KafkaSettings:          settings,
+		KafkaRestEnabled:       ptr.Deref(external.KafkaRestEnabled, false),
+		KafkaRestSettings:      restSettings,
+		KafkaConnectEnabled:    ptr.Deref(external.KafkaConnectEnabled, false),
+		KafkaConnectSettings:   connectSettings,
+		SchemaRegistryEnabled:  ptr.Deref(external.SchemaRegistryEnabled, false),
+		SchemaRegistrySettings: registrySettings,
+		NodeStates:             nodeStates,
+		Notifications:          notifications,
+		Backups:                backups,
+		LastBackupTime:         lastBackupTime,
 	}, nil
 }
 func getCondition(external *exoscalesdk.DBAASServiceKafka) (xpv1.Condition, error) {
@@ -173,6 +213,12 @@ func getConnectionDetails(external *exoscalesdk.DBAASServiceKafka, ca string) (m
 	if external.KafkaRestEnabled != nil && *external.KafkaRestEnabled && external.ConnectionInfo.RestURI != "" {
 		details["KAFKA_REST_URI"] = []byte(external.ConnectionInfo.RestURI)
 	}
+	if external.KafkaConnectEnabled != nil && *external.KafkaConnectEnabled && external.ConnectionInfo.ConnectURI != "" {
+		details["KAFKA_CONNECT_URI"] = []byte(external.ConnectionInfo.ConnectURI)
+	}
+	if external.SchemaRegistryEnabled != nil && *external.SchemaRegistryEnabled && external.ConnectionInfo.RegistryURI != "" {
+		details["KAFKA_SCHEMA_REGISTRY_URI"] = []byte(external.ConnectionInfo.RegistryURI)
+	}
 
 	return details, nil
 }
@@ -195,18 +241,48 @@ func diffParameters(external *exoscalesdk.DBAASServiceKafka, expected exoscalev1
 	}
 	actualKafkaRestSettings := runtime.RawExtension{Raw: jsonKafkaRestSettings}
 
+	jsonKafkaConnectSettings, err := json.Marshal(external.KafkaConnectSettings)
+	if err != nil {
+		return false, err.Error()
+	}
+	actualKafkaConnectSettings := runtime.RawExtension{Raw: jsonKafkaConnectSettings}
+
+	jsonSchemaRegistrySettings, err := json.Marshal(external.SchemaRegistrySettings)
+	if err != nil {
+		return false, err.Error()
+	}
+	actualSchemaRegistrySettings := runtime.RawExtension{Raw: jsonSchemaRegistrySettings}
+
 	actual := exoscalev1.KafkaParameters{
 		Maintenance: exoscalev1.MaintenanceSpec{
 			DayOfWeek: external.Maintenance.Dow,
 			TimeOfDay: exoscalev1.TimeOfDay(external.Maintenance.Time),
 		},
-		Zone:              expected.Zone,
-		DBaaSParameters:   mapper.ToDBaaSParameters(external.TerminationProtection, external.Plan, &actualIPFilter),
-		Version:           expected.Version, // We should never mark somthing as out of date if the versions don't match as update can't modify the version anyway
-		KafkaSettings:     actualKafkaSettings,
-		KafkaRestEnabled:  ptr.Deref(external.KafkaRestEnabled, false),
-		KafkaRestSettings: actualKafkaRestSettings,
+		Zone:                   expected.Zone,
+		DBaaSParameters:        mapper.ToDBaaSParameters(external.TerminationProtection, external.Plan, &actualIPFilter),
+		Version:                expected.Version, // We should never mark somthing as out of date if the versions don't match as update can't modify the version anyway
+		KafkaSettings:          actualKafkaSettings,
+		KafkaRestEnabled:       ptr.Deref(external.KafkaRestEnabled, false),
+		KafkaRestSettings:      actualKafkaRestSettings,
+		KafkaConnectEnabled:    ptr.Deref(external.KafkaConnectEnabled, false),
+		KafkaConnectSettings:   actualKafkaConnectSettings,
+		SchemaRegistryEnabled:  ptr.Deref(external.SchemaRegistryEnabled, false),
+		SchemaRegistrySettings: actualSchemaRegistrySettings,
+		// Attachments, backup monitoring and the settings policy aren't part of the service, they're observed separately
+		ExternalEndpoints: expected.ExternalEndpoints,
+		MaxBackupAge:      expected.MaxBackupAge,
+		SettingsPolicy:    expected.SettingsPolicy,
+	}
+	if expected.SettingsPolicy == exoscalev1.SettingsPolicyManagedKeysOnly {
+		// Comparers must be symmetric, hence the observed settings are reduced to the managed ones beforehand.
+		actual.KafkaSettings = mapper.ManagedSettings(expected.KafkaSettings, actual.KafkaSettings)
+		actual.KafkaRestSettings = mapper.ManagedSettings(expected.KafkaRestSettings, actual.KafkaRestSettings)
+		actual.KafkaConnectSettings = mapper.ManagedSettings(expected.KafkaConnectSettings, actual.KafkaConnectSettings)
+		actual.SchemaRegistrySettings = mapper.ManagedSettings(expected.SchemaRegistrySettings, actual.SchemaRegistrySettings)
 	}
 	settingComparer := cmp.Comparer(mapper.CompareSettings)
-	return cmp.Equal(expected, actual, settingComparer), cmp.Diff(expected, actual, settingComparer)
+	ipFilterComparer := cmp.Comparer(func(a, b exoscalev1.IPFilter) bool {
+		return mapper.IsSameIPFilter(a, (*[]string)(&b))
+	})
+	return cmp.Equal(expected, actual, settingComparer, ipFilterComparer), cmp.Diff(expected, actual, settingComparer, ipFilterComparer)
 }
@@ -0,0 +1,91 @@
+package kafkacontroller
+
+import (
+	"testing"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func TestGetConnectionDetails(t *testing.T) {
+	base := func() *exoscalesdk.DBAASServiceKafka {
+		return &exoscalesdk.DBAASServiceKafka{
+			URI:       "kafka-instance.aivencloud.com:21701",
+			URIParams: map[string]any{"host": "kafka-instance.aivencloud.com", "port": "21701"},
+			ConnectionInfo: &exoscalesdk.DBAASServiceKafkaConnectionInfo{
+				Nodes:       []string{"10.0.0.1:21701"},
+				AccessCert:  "cert",
+				AccessKey:   "key",
+				RestURI:     "https://rest.aivencloud.com:21702",
+				ConnectURI:  "https://connect.aivencloud.com:21703",
+				RegistryURI: "https://registry.aivencloud.com:21704",
+			},
+		}
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		details, err := getConnectionDetails(base(), "ca")
+		require.NoError(t, err)
+		assert.NotContains(t, details, "KAFKA_REST_URI")
+		assert.NotContains(t, details, "KAFKA_CONNECT_URI")
+		assert.NotContains(t, details, "KAFKA_SCHEMA_REGISTRY_URI")
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		kafka := base()
+		kafka.KafkaRestEnabled = ptr.To(true)
+		kafka.KafkaConnectEnabled = ptr.To(true)
+		kafka.SchemaRegistryEnabled = ptr.To(true)
+
+		details, err := getConnectionDetails(kafka, "ca")
+		require.NoError(t, err)
+		assert.Equal(t, "https://rest.aivencloud.com:21702", string(details["KAFKA_REST_URI"]))
+		assert.Equal(t, "https://connect.aivencloud.com:21703", string(details["KAFKA_CONNECT_URI"]))
+		assert.Equal(t, "https://registry.aivencloud.com:21704", string(details["KAFKA_SCHEMA_REGISTRY_URI"]))
+	})
+}
+
+func TestDiffParameters_SettingsPolicy(t *testing.T) {
+	external := &exoscalesdk.DBAASServiceKafka{
+		Plan:        "startup-2",
+		IPFilter:    []string{"0.0.0.0/0"},
+		Maintenance: &exoscalesdk.DBAASServiceMaintenance{Dow: "monday", Time: "10:00:00"},
+		KafkaSettings: exoscalesdk.JSONSchemaKafka{
+			AutoCreateTopicsEnable:   ptr.To(true),
+			DefaultReplicationFactor: 3,
+		},
+	}
+	expected := exoscalev1.KafkaParameters{
+		Maintenance:     exoscalev1.MaintenanceSpec{DayOfWeek: "monday", TimeOfDay: "10:00:00"},
+		Zone:            "ch-gva-2",
+		DBaaSParameters: exoscalev1.DBaaSParameters{Size: exoscalev1.SizeSpec{Plan: "startup-2"}, IPFilter: []string{"0.0.0.0/0"}},
+		KafkaSettings:   runtime.RawExtension{Raw: []byte(`{"auto_create_topics_enable":true}`)},
+	}
+
+	t.Run("Full", func(t *testing.T) {
+		expected.SettingsPolicy = exoscalev1.SettingsPolicyFull
+		upToDate, _ := diffParameters(external, expected)
+		assert.False(t, upToDate)
+	})
+	t.Run("ManagedKeysOnly", func(t *testing.T) {
+		expected.SettingsPolicy = exoscalev1.SettingsPolicyManagedKeysOnly
+		upToDate, diff := diffParameters(external, expected)
+		assert.True(t, upToDate, diff)
+	})
+	t.Run("ExternalEndpoints", func(t *testing.T) {
+		attached := expected
+		attached.ExternalEndpoints = []exoscalev1.ExternalEndpointAttachment{{Name: "prometheus"}}
+		upToDate, diff := diffParameters(external, attached)
+		assert.True(t, upToDate, diff)
+	})
+	t.Run("ManagedKeysOnly_Changed", func(t *testing.T) {
+		expected.SettingsPolicy = exoscalev1.SettingsPolicyManagedKeysOnly
+		expected.KafkaSettings = runtime.RawExtension{Raw: []byte(`{"auto_create_topics_enable":false}`)}
+		upToDate, _ := diffParameters(external, expected)
+		assert.False(t, upToDate)
+	})
+}
@@ -29,6 +29,14 @@ func setSettingsDefaults(ctx context.Context, f settingsFetcher, in *exoscalev1.
 	if err != nil {
 		return nil, err
 	}
+	res.KafkaConnectSettings, err = s.SetDefaults("kafka-connect", res.KafkaConnectSettings)
+	if err != nil {
+		return nil, err
+	}
+	res.SchemaRegistrySettings, err = s.SetDefaults("schema-registry", res.SchemaRegistrySettings)
+	if err != nil {
+		return nil, err
+	}
 
 	return res, nil
 }
@@ -7,6 +7,8 @@ import (
 
 	exoscalesdk "github.com/exoscale/egoscale/v3"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/externalendpoint"
+	"github.com/vshn/provider-exoscale/operator/trigger"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -23,6 +25,10 @@ func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, fmt.Errorf("invalid managed resource type %T for kafka connection", mg)
 	}
 
+	instance.Status.AtProvider.Triggers = trigger.Handle(ctx, p.recorder, instance, instance.GetInstanceName(), trigger.Actions{
+		trigger.MaintenanceAnnotation: p.exo.StartDBAASKafkaMaintenance,
+	}, instance.Status.AtProvider.Triggers)
+
 	spec := instance.Spec.ForProvider
 	ipFilter := []string(spec.IPFilter)
 	settings := exoscalesdk.JSONSchemaKafka{}
@@ -40,6 +46,20 @@ func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			return managed.ExternalUpdate{}, fmt.Errorf("invalid kafka rest settings: %w", err)
 		}
 	}
+	connectSettings := exoscalesdk.JSONSchemaKafkaConnect{}
+	if len(spec.KafkaConnectSettings.Raw) != 0 {
+		err := json.Unmarshal(spec.KafkaConnectSettings.Raw, &connectSettings)
+		if err != nil {
+			return managed.ExternalUpdate{}, fmt.Errorf("invalid kafka connect settings: %w", err)
+		}
+	}
+	registrySettings := exoscalesdk.JSONSchemaSchemaRegistry{}
+	if len(spec.SchemaRegistrySettings.Raw) != 0 {
+		err := json.Unmarshal(spec.SchemaRegistrySettings.Raw, &registrySettings)
+		if err != nil {
+			return managed.ExternalUpdate{}, fmt.Errorf("invalid schema registry settings: %w", err)
+		}
+	}
 
 	body := exoscalesdk.UpdateDBAASServiceKafkaRequest{
 		IPFilter:      ipFilter,
@@ -48,10 +68,14 @@ func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			Dow:  exoscalesdk.UpdateDBAASServiceKafkaRequestMaintenanceDow(spec.Maintenance.DayOfWeek),
 			Time: spec.Maintenance.TimeOfDay.String(),
 		},
-		Plan:                  spec.Size.Plan,
-		TerminationProtection: &spec.TerminationProtection,
-		KafkaRestEnabled:      &spec.KafkaRestEnabled,
-		KafkaRestSettings:     restSettings,
+		Plan:                   spec.Size.Plan,
+		TerminationProtection:  &spec.TerminationProtection,
+		KafkaRestEnabled:       &spec.KafkaRestEnabled,
+		KafkaRestSettings:      restSettings,
+		KafkaConnectEnabled:    &spec.KafkaConnectEnabled,
+		KafkaConnectSettings:   connectSettings,
+		SchemaRegistryEnabled:  &spec.SchemaRegistryEnabled,
+		SchemaRegistrySettings: registrySettings,
 	}
 
 	resp, err := p.exo.UpdateDBAASServiceKafka(ctx, instance.GetInstanceName(), body)
@@ -59,5 +83,9 @@ func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, fmt.Errorf("unable to update instance: %w", err)
 	}
 	log.V(2).Info("response", "message", string(resp.Message))
+	err = externalendpoint.Update(ctx, p.kube, p.exo, instance.GetInstanceName(), spec.ExternalEndpoints, instance.Status.AtProvider.ExternalEndpoints)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 	return managed.ExternalUpdate{}, nil
 }
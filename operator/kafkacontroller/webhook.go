@@ -15,6 +15,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 const serviceType = "kafka"
@@ -28,12 +29,19 @@ type Validator struct {
 // ValidateCreate validates the spec of a created kafka resource.
 func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	instance := obj.(*exoscalev1.Kafka)
-	v.log.V(1).Info("get kafka available versions")
+	exo, err := v.openExoscaleClient(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+	return nil, v.validateCreateWithExoClient(ctx, obj, exo)
+}
+
+func (v *Validator) openExoscaleClient(ctx context.Context, instance *exoscalev1.Kafka) (*exoscalesdk.Client, error) {
 	exo, err := pipelineutil.OpenExoscaleClient(ctx, v.kube, instance.GetProviderConfigName(), exoscalesdk.ClientOptWithEndpoint(common.ZoneTranslation[instance.Spec.ForProvider.Zone]))
 	if err != nil {
 		return nil, fmt.Errorf("open exoscale client failed: %w", err)
 	}
-	return nil, v.validateCreateWithExoClient(ctx, obj, exo.Exoscale)
+	return exo.Exoscale, nil
 }
 
 func (v *Validator) validateCreateWithExoClient(ctx context.Context, obj runtime.Object, exo *exoscalesdk.Client) error {
@@ -43,34 +51,97 @@ func (v *Validator) validateCreateWithExoClient(ctx context.Context, obj runtime
 	}
 	v.log.V(2).WithValues("instance", instance).Info("validate create")
 
+	dbaasType, err := v.getServiceType(ctx, exo)
+	if err != nil {
+		return err
+	}
+
 	if instance.Spec.ForProvider.Version != "" {
-		availableVersions, err := v.getAvailableVersions(ctx, exo)
-		if err != nil {
-			return err
+		if dbaasType.AvailableVersions == nil {
+			return fmt.Errorf("kafka available versions not found")
 		}
-
-		err = v.validateVersion(ctx, obj, availableVersions)
+		err = v.validateVersion(ctx, obj, dbaasType.AvailableVersions)
 		if err != nil {
-			return fmt.Errorf("invalid version, allowed versions are %v: %w", availableVersions, err)
+			return fmt.Errorf("invalid version, allowed versions are %v: %w", dbaasType.AvailableVersions, err)
 		}
 	}
 
-	return validateSpec(instance.Spec.ForProvider)
+	err = webhook.ValidatePlan(instance.Spec.ForProvider.Size.Plan, instance.Spec.ForProvider.Zone.String(), dbaasType.Plans)
+	if err != nil {
+		return err
+	}
+
+	err = validateIpFilter(instance.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	err = validateSpec(instance.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	return v.validateSettingsSchema(ctx, exo, webhook.ChangedSettings(nil, settingsOf(instance.Spec.ForProvider)))
 }
 
-func (v *Validator) getAvailableVersions(ctx context.Context, exo *exoscalesdk.Client) ([]string, error) {
-	// get kafka available versions
+// validateSettingsSchema validates the given settings against the settings schema of the service.
+func (v *Validator) validateSettingsSchema(ctx context.Context, exo *exoscalesdk.Client, changed []webhook.Setting) error {
+	if len(changed) == 0 {
+		return nil
+	}
+	schemas, err := fetchSettingSchema(ctx, exo)
+	if err != nil {
+		return fmt.Errorf("cannot fetch settings schema: %w", err)
+	}
+	return webhook.ValidateSettings(schemas, changed)
+}
+
+// validateSettingsChange validates the settings that changed against the settings schema of the service.
+func (v *Validator) validateSettingsChange(ctx context.Context, oldInst, newInst exoscalev1.Kafka) error {
+	changed := webhook.ChangedSettings(settingsOf(oldInst.Spec.ForProvider), settingsOf(newInst.Spec.ForProvider))
+	if len(changed) == 0 {
+		return nil
+	}
+	exo, err := v.openExoscaleClient(ctx, &newInst)
+	if err != nil {
+		return err
+	}
+	return v.validateSettingsSchema(ctx, exo, changed)
+}
+
+func settingsOf(params exoscalev1.KafkaParameters) []webhook.Setting {
+	path := field.NewPath("spec", "forProvider")
+	return []webhook.Setting{
+		{Schema: "kafka", Path: path.Child("kafkaSettings"), Value: params.KafkaSettings},
+		{Schema: "kafka-rest", Path: path.Child("kafkaRestSettings"), Value: params.KafkaRestSettings},
+		{Schema: "kafka-connect", Path: path.Child("kafkaConnectSettings"), Value: params.KafkaConnectSettings},
+		{Schema: "schema-registry", Path: path.Child("schemaRegistrySettings"), Value: params.SchemaRegistrySettings},
+	}
+}
+
+func (v *Validator) getServiceType(ctx context.Context, exo *exoscalesdk.Client) (*exoscalesdk.DBAASServiceType, error) {
 	resp, err := exo.GetDBAASServiceType(ctx, serviceType)
 	if err != nil {
 		return nil, fmt.Errorf("get DBaaS service type failed: %w", err)
 	}
 
 	v.log.V(1).Info("DBaaS service type", "name", string(resp.Name), "description", string(resp.Description))
+	return resp, nil
+}
 
-	if resp.AvailableVersions == nil {
-		return nil, fmt.Errorf("kafka available versions not found")
+// validatePlanChange validates a changed plan and warns about downgrades.
+func (v *Validator) validatePlanChange(ctx context.Context, oldInst, newInst exoscalev1.Kafka) (admission.Warnings, error) {
+	oldPlan, newPlan := oldInst.Spec.ForProvider.Size.Plan, newInst.Spec.ForProvider.Size.Plan
+	if oldPlan == newPlan {
+		return nil, nil
 	}
-	return resp.AvailableVersions, nil
+	exo, err := v.openExoscaleClient(ctx, &newInst)
+	if err != nil {
+		return nil, err
+	}
+	dbaasType, err := v.getServiceType(ctx, exo)
+	if err != nil {
+		return nil, err
+	}
+	return webhook.ValidatePlanChange(oldPlan, newPlan, newInst.Spec.ForProvider.Zone.String(), dbaasType.Plans)
 }
 
 func (v *Validator) validateVersion(_ context.Context, obj runtime.Object, availableVersions []string) error {
@@ -81,7 +152,7 @@ func (v *Validator) validateVersion(_ context.Context, obj runtime.Object, avail
 }
 
 // ValidateUpdate validates the spec of an updated kafka resource and checks that no immutable field has been modified.
-func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	newInstance, ok := newObj.(*exoscalev1.Kafka)
 	if !ok {
 		return nil, fmt.Errorf("invalid managed resource type %T for kafka webhook", newObj)
@@ -92,25 +163,37 @@ func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Obj
 	}
 	v.log.V(2).WithValues("old", oldInstance, "new", newInstance).Info("VALIDATE update")
 
-	err := validateSpec(newInstance.Spec.ForProvider)
+	err := webhook.ValidateIPFilterUpdate(oldInstance.Spec.ForProvider.IPFilter, newInstance.Spec.ForProvider.IPFilter)
+	if err != nil {
+		return nil, err
+	}
+	err = validateSpec(newInstance.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	err = validateImmutable(*oldInstance, *newInstance)
 	if err != nil {
 		return nil, err
 	}
-	return nil, validateImmutable(*oldInstance, *newInstance)
+	err = v.validateSettingsChange(ctx, *oldInstance, *newInstance)
+	if err != nil {
+		return nil, err
+	}
+	return v.validatePlanChange(ctx, *oldInstance, *newInstance)
 }
 
-// ValidateDelete validates a delete. Currently does not validate anything.
-func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
-	v.log.V(2).Info("validate delete (noop)")
-	return nil, nil
+// ValidateDelete implements admission.CustomValidator.
+func (v *Validator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	instance, ok := obj.(*exoscalev1.Kafka)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for kafka webhook", obj)
+	}
+	v.log.V(2).Info("validate delete")
+	return nil, webhook.ValidateTerminationProtection(instance, instance.Spec.ForProvider.TerminationProtection, false)
 }
 
 func validateSpec(params exoscalev1.KafkaParameters) error {
-	err := validateIpFilter(params)
-	if err != nil {
-		return err
-	}
-	err = validateMaintenanceSchedule(params)
+	err := validateMaintenanceSchedule(params)
 	if err != nil {
 		return err
 	}
@@ -118,10 +201,7 @@ func validateSpec(params exoscalev1.KafkaParameters) error {
 }
 
 func validateIpFilter(params exoscalev1.KafkaParameters) error {
-	if len(params.IPFilter) == 0 {
-		return fmt.Errorf("IP filter cannot be empty")
-	}
-	return nil
+	return webhook.ValidateIPFilter(params.IPFilter)
 }
 
 func validateMaintenanceSchedule(params exoscalev1.KafkaParameters) error {
@@ -0,0 +1,34 @@
+package kafkausercontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/common"
+	"github.com/vshn/provider-exoscale/operator/pipelineutil"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type connector struct {
+	kube     client.Client
+	recorder event.Recorder
+}
+
+// Connect implements managed.ExternalConnecter.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(1).Info("Connecting resource")
+
+	user := mg.(*exoscalev1.KafkaUser)
+
+	exo, err := pipelineutil.OpenExoscaleClient(ctx, c.kube, user.GetProviderConfigName(), exoscalesdk.ClientOptWithEndpoint(common.ZoneTranslation[user.Spec.ForProvider.Zone]))
+	if err != nil {
+		return nil, err
+	}
+	return newPipeline(c.kube, c.recorder, exo.Exoscale), nil
+}
@@ -0,0 +1,108 @@
+package kafkausercontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/pipelineutil"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	user := mg.(*exoscalev1.KafkaUser)
+
+	kafka, err := p.exo.GetDBAASServiceKafka(ctx, user.Spec.ForProvider.InstanceName)
+	if err != nil {
+		if errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, fmt.Errorf("cannot observe Kafka instance: %w", err)
+	}
+
+	observed := findUser(kafka.Users, user.GetUsername())
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	user.Status.AtProvider = mapObservation(observed)
+
+	secret, err := p.exo.RevealDBAASKafkaUserPassword(ctx, string(kafka.Name), user.GetUsername())
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("cannot reveal credentials for Kafka user: %w", err)
+	}
+
+	desiredPassword, err := pipelineutil.FetchPassword(ctx, p.kube, user.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	caCert, err := p.exo.GetDBAASCACertificate(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot retrieve CA certificate")
+	}
+
+	user.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  desiredPassword == "" || desiredPassword == secret.Password,
+		ConnectionDetails: connectionDetails(kafka, secret, caCert.Certificate),
+	}, nil
+}
+
+func findUser(users []exoscalesdk.DBAASServiceKafkaUsers, username string) *exoscalesdk.DBAASServiceKafkaUsers {
+	for _, u := range users {
+		if u.Username == username {
+			return &u
+		}
+	}
+	return nil
+}
+
+// mapObservation fills the status fields from the given user.
+func mapObservation(user *exoscalesdk.DBAASServiceKafkaUsers) exoscalev1.KafkaUserObservation {
+	observation := exoscalev1.KafkaUserObservation{
+		Username: user.Username,
+		Type:     user.Type,
+	}
+	if !user.AccessCertExpiry.IsZero() {
+		observation.AccessCertExpiry = &metav1.Time{Time: user.AccessCertExpiry}
+	}
+	return observation
+}
+
+// connectionDetails returns the connection details of the instance with the credentials of the given user.
+// Unlike the instance secret, the certificate and key belong to the user.
+func connectionDetails(in *exoscalesdk.DBAASServiceKafka, secret *exoscalesdk.DBAASUserKafkaSecrets, ca string) managed.ConnectionDetails {
+	details := map[string][]byte{
+		"KAFKA_USER":     []byte(secret.Username),
+		"KAFKA_PASSWORD": []byte(secret.Password),
+		"service.cert":   []byte(secret.AccessCert),
+		"service.key":    []byte(secret.AccessKey),
+		"ca.crt":         []byte(ca),
+	}
+	if in.URI != "" {
+		details["KAFKA_URI"] = []byte(in.URI)
+	}
+	if in.URIParams != nil {
+		host, _ := in.URIParams["host"].(string)
+		port, _ := in.URIParams["port"].(string)
+		details["KAFKA_HOST"] = []byte(host)
+		details["KAFKA_PORT"] = []byte(port)
+	}
+	if in.ConnectionInfo != nil && in.ConnectionInfo.Nodes != nil {
+		details["KAFKA_NODES"] = []byte(strings.Join(in.ConnectionInfo.Nodes, " "))
+	}
+	return details
+}
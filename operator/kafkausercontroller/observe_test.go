@@ -0,0 +1,60 @@
+package kafkausercontroller
+
+import (
+	"testing"
+	"time"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConnectionDetails(t *testing.T) {
+	kafka := &exoscalesdk.DBAASServiceKafka{
+		URI:       "kafka-instance.aivencloud.com:21701",
+		URIParams: map[string]any{"host": "kafka-instance.aivencloud.com", "port": "21701"},
+		ConnectionInfo: &exoscalesdk.DBAASServiceKafkaConnectionInfo{
+			Nodes:      []string{"10.0.0.1:21701", "10.0.0.2:21701"},
+			AccessCert: "service-cert",
+			AccessKey:  "service-key",
+		},
+	}
+	secret := &exoscalesdk.DBAASUserKafkaSecrets{
+		Username:   "app",
+		Password:   "secret",
+		AccessCert: "user-cert",
+		AccessKey:  "user-key",
+	}
+
+	details := connectionDetails(kafka, secret, "ca")
+
+	assert.Equal(t, "app", string(details["KAFKA_USER"]))
+	assert.Equal(t, "secret", string(details["KAFKA_PASSWORD"]))
+	assert.Equal(t, "user-cert", string(details["service.cert"]))
+	assert.Equal(t, "user-key", string(details["service.key"]))
+	assert.Equal(t, "ca", string(details["ca.crt"]))
+	assert.Equal(t, "kafka-instance.aivencloud.com:21701", string(details["KAFKA_URI"]))
+	assert.Equal(t, "kafka-instance.aivencloud.com", string(details["KAFKA_HOST"]))
+	assert.Equal(t, "21701", string(details["KAFKA_PORT"]))
+	assert.Equal(t, "10.0.0.1:21701 10.0.0.2:21701", string(details["KAFKA_NODES"]))
+}
+
+func TestFindUser(t *testing.T) {
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := []exoscalesdk.DBAASServiceKafkaUsers{
+		{Username: "avnadmin", Type: "primary"},
+		{Username: "app", Type: "normal", AccessCertExpiry: expiry},
+	}
+
+	found := findUser(users, "app")
+	require.NotNil(t, found)
+	assert.Equal(t, exoscalev1.KafkaUserObservation{
+		Username:         "app",
+		Type:             "normal",
+		AccessCertExpiry: &metav1.Time{Time: expiry},
+	}, mapObservation(found))
+
+	assert.Nil(t, findUser(users, "missing"))
+}
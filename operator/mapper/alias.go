@@ -2,10 +2,12 @@ package mapper
 
 import (
 	"fmt"
+	"time"
 
 	exoscalesdk "github.com/exoscale/egoscale/v3"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/utils/ptr"
@@ -25,6 +27,45 @@ func ToBackupSchedule(day exoscalev1.TimeOfDay) (BackupSchedule, error) {
 	}, err
 }
 
+// ToRecoveryBackupTime returns the ISO time of the backup to recover from.
+// The target time takes precedence over the backup name, which is looked up in the given backups.
+// Returns an empty string if neither is given.
+func ToRecoveryBackupTime(backups []exoscalesdk.DBAASServiceBackup, backupName string, targetTime *metav1.Time) (string, error) {
+	if targetTime != nil {
+		return targetTime.UTC().Format(time.RFC3339), nil
+	}
+	if backupName == "" {
+		return "", nil
+	}
+	for _, backup := range backups {
+		if backup.BackupName == backupName {
+			return backup.BackupTime.UTC().Format(time.RFC3339), nil
+		}
+	}
+	return "", fmt.Errorf("backup %q not found", backupName)
+}
+
+// ToBackups converts the given backups and returns the time of the most recent one.
+// Returns nil as time if there are no backups.
+func ToBackups(backups []exoscalesdk.DBAASServiceBackup) ([]exoscalev1.Backup, *metav1.Time) {
+	if backups == nil {
+		return nil, nil
+	}
+	var last *metav1.Time
+	s := make([]exoscalev1.Backup, len(backups))
+	for i, backup := range backups {
+		s[i] = exoscalev1.Backup{
+			Name: backup.BackupName,
+			Time: metav1.NewTime(backup.BackupTime),
+			Size: backup.DataSize,
+		}
+		if last == nil || backup.BackupTime.After(last.Time) {
+			last = &metav1.Time{Time: backup.BackupTime}
+		}
+	}
+	return s, last
+}
+
 func ToSlicePtr(arr []string) *[]string {
 	return &arr
 }
@@ -2,10 +2,12 @@ package mapper
 
 import (
 	"testing"
+	"time"
 
 	exoscalesdk "github.com/exoscale/egoscale/v3"
 	"github.com/stretchr/testify/assert"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestToBackupSpec(t *testing.T) {
@@ -128,3 +130,73 @@ func TestToNodeState(t *testing.T) {
 		})
 	}
 }
+
+func TestToRecoveryBackupTime(t *testing.T) {
+	backups := []exoscalesdk.DBAASServiceBackup{
+		{BackupName: "backup-1", BackupTime: time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC)},
+		{BackupName: "backup-2", BackupTime: time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC)},
+	}
+	tests := map[string]struct {
+		givenName     string
+		givenTime     *metav1.Time
+		expectedTime  string
+		expectedError string
+	}{
+		"NothingGiven": {
+			expectedTime: "",
+		},
+		"BackupName": {
+			givenName:    "backup-2",
+			expectedTime: "2024-01-02T02:00:00Z",
+		},
+		"UnknownBackupName": {
+			givenName:     "backup-3",
+			expectedError: `backup "backup-3" not found`,
+		},
+		"TargetTime": {
+			givenTime:    &metav1.Time{Time: time.Date(2024, 1, 1, 14, 30, 0, 0, time.FixedZone("CET", 3600))},
+			expectedTime: "2024-01-01T13:30:00Z",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := ToRecoveryBackupTime(backups, tc.givenName, tc.givenTime)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedTime, result)
+		})
+	}
+}
+
+func TestToBackups(t *testing.T) {
+	first := time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC)
+	second := time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		givenBackups    []exoscalesdk.DBAASServiceBackup
+		expectedBackups []exoscalev1.Backup
+		expectedLast    *metav1.Time
+	}{
+		"NoBackups": {},
+		"UnorderedBackups": {
+			givenBackups: []exoscalesdk.DBAASServiceBackup{
+				{BackupName: "backup-2", BackupTime: second, DataSize: 2048},
+				{BackupName: "backup-1", BackupTime: first, DataSize: 1024},
+			},
+			expectedBackups: []exoscalev1.Backup{
+				{Name: "backup-2", Time: metav1.NewTime(second), Size: 2048},
+				{Name: "backup-1", Time: metav1.NewTime(first), Size: 1024},
+			},
+			expectedLast: &metav1.Time{Time: second},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			backups, last := ToBackups(tc.givenBackups)
+			assert.Equal(t, tc.expectedBackups, backups)
+			assert.Equal(t, tc.expectedLast, last)
+		})
+	}
+}
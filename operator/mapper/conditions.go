@@ -1,7 +1,11 @@
 package mapper
 
 import (
+	"time"
+
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // FindStatusCondition returns the condition by the given type if present.
@@ -14,3 +18,43 @@ func FindStatusCondition(c []xpv1.Condition, conditionType xpv1.ConditionType) *
 	}
 	return nil
 }
+
+// RemoveStatusCondition removes the condition of the given type from the given status.
+func RemoveStatusCondition(s *xpv1.ConditionedStatus, conditionType xpv1.ConditionType) {
+	conditions := make([]xpv1.Condition, 0, len(s.Conditions))
+	for _, c := range s.Conditions {
+		if c.Type != conditionType {
+			conditions = append(conditions, c)
+		}
+	}
+	s.Conditions = conditions
+}
+
+// SetBackupCondition sets the BackupUpToDate condition for the given time of the most recent backup.
+// Removes the condition if no maximum age is given.
+func SetBackupCondition(s *xpv1.ConditionedStatus, lastBackup *metav1.Time, createdAt time.Time, maxAge *metav1.Duration, now time.Time) {
+	c := ToBackupCondition(lastBackup, createdAt, maxAge, now)
+	if c == nil {
+		RemoveStatusCondition(s, exoscalev1.TypeBackupUpToDate)
+		return
+	}
+	s.SetConditions(*c)
+}
+
+// ToBackupCondition returns the BackupUpToDate condition for the given time of the most recent backup.
+// Services without any backup yet are measured by their creation time.
+// Returns nil if no maximum age is given.
+func ToBackupCondition(lastBackup *metav1.Time, createdAt time.Time, maxAge *metav1.Duration, now time.Time) *xpv1.Condition {
+	if maxAge == nil {
+		return nil
+	}
+	since := createdAt
+	if lastBackup != nil {
+		since = lastBackup.Time
+	}
+	condition := exoscalev1.BackupRecent(maxAge.Duration)
+	if now.Sub(since) > maxAge.Duration {
+		condition = exoscalev1.BackupOutdated(maxAge.Duration)
+	}
+	return &condition
+}
@@ -0,0 +1,76 @@
+package mapper
+
+import (
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestToBackupCondition(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	maxAge := &metav1.Duration{Duration: 26 * time.Hour}
+	tests := map[string]struct {
+		givenLastBackup *metav1.Time
+		givenCreatedAt  time.Time
+		givenMaxAge     *metav1.Duration
+		expectedReason  xpv1.ConditionReason
+	}{
+		"NoMaxAge": {
+			givenLastBackup: &metav1.Time{Time: now.Add(-72 * time.Hour)},
+		},
+		"RecentBackup": {
+			givenLastBackup: &metav1.Time{Time: now.Add(-2 * time.Hour)},
+			givenCreatedAt:  now.Add(-720 * time.Hour),
+			givenMaxAge:     maxAge,
+			expectedReason:  exoscalev1.ReasonBackupRecent,
+		},
+		"OutdatedBackup": {
+			givenLastBackup: &metav1.Time{Time: now.Add(-27 * time.Hour)},
+			givenCreatedAt:  now.Add(-720 * time.Hour),
+			givenMaxAge:     maxAge,
+			expectedReason:  exoscalev1.ReasonBackupOutdated,
+		},
+		"NewServiceWithoutBackup": {
+			givenCreatedAt: now.Add(-1 * time.Hour),
+			givenMaxAge:    maxAge,
+			expectedReason: exoscalev1.ReasonBackupRecent,
+		},
+		"OldServiceWithoutBackup": {
+			givenCreatedAt: now.Add(-48 * time.Hour),
+			givenMaxAge:    maxAge,
+			expectedReason: exoscalev1.ReasonBackupOutdated,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			condition := ToBackupCondition(tc.givenLastBackup, tc.givenCreatedAt, tc.givenMaxAge, now)
+			if tc.expectedReason == "" {
+				assert.Nil(t, condition)
+				return
+			}
+			require.NotNil(t, condition)
+			assert.Equal(t, exoscalev1.TypeBackupUpToDate, condition.Type)
+			assert.Equal(t, tc.expectedReason, condition.Reason)
+		})
+	}
+}
+
+func TestSetBackupCondition(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	lastBackup := &metav1.Time{Time: now.Add(-27 * time.Hour)}
+	s := &xpv1.ConditionedStatus{}
+	s.SetConditions(xpv1.Available())
+
+	SetBackupCondition(s, lastBackup, now, &metav1.Duration{Duration: 26 * time.Hour}, now)
+	assert.Equal(t, exoscalev1.ReasonBackupOutdated, s.GetCondition(exoscalev1.TypeBackupUpToDate).Reason)
+
+	// The condition must not linger once the maximum age is removed.
+	SetBackupCondition(s, lastBackup, now, nil, now)
+	assert.Nil(t, FindStatusCondition(s.Conditions, exoscalev1.TypeBackupUpToDate))
+	assert.Equal(t, xpv1.ReasonAvailable, s.GetCondition(xpv1.TypeReady).Reason)
+}
@@ -1,8 +1,16 @@
 package mapper
 
 import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"net/netip"
+	"strings"
+
 	"github.com/hashicorp/go-version"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 // IsSameStringSet returns true if both slices have the same unique elements in any order.
@@ -23,6 +31,47 @@ func IsSameStringSet(a []string, b *[]string) bool {
 	return true
 }
 
+// IsSameIPFilter returns true if both IP filters allow the same addresses.
+// Entries are compared as normalised prefixes, so that e.g. `10.0.0.1` and `10.0.0.1/32` are equal.
+func IsSameIPFilter(a []string, b *[]string) bool {
+	if b == nil {
+		return len(a) == 0
+	}
+	return IsSameStringSet(NormalizeIPFilter(a), ptr.To(NormalizeIPFilter(*b)))
+}
+
+// NormalizeIPFilter converts the entries of an IP filter to prefixes in canonical form.
+// Single addresses become host prefixes and host bits are masked.
+// Entries that can't be parsed are returned unchanged.
+func NormalizeIPFilter(filter []string) []string {
+	normalized := make([]string, len(filter))
+	for i, entry := range filter {
+		prefix, err := ParseIPFilterEntry(entry)
+		if err != nil {
+			normalized[i] = entry
+			continue
+		}
+		normalized[i] = prefix.String()
+	}
+	return normalized
+}
+
+// ParseIPFilterEntry parses an IP address or CIDR range into a masked prefix.
+func ParseIPFilterEntry(entry string) (netip.Prefix, error) {
+	if strings.Contains(entry, "/") {
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(entry)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
 func set(s []string) map[string]struct{} {
 	m := make(map[string]struct{})
 	for _, i := range s {
@@ -31,25 +80,136 @@ func set(s []string) map[string]struct{} {
 	return m
 }
 
+// CompareSettings returns true if both settings contain the same keys with equal values.
+// Nested objects and arrays are compared deeply, numbers are compared by value so that e.g. `1` and `1.0` are equal.
 func CompareSettings(a, b runtime.RawExtension) bool {
-	sa, err := ToMap(a)
+	sa, err := toSettingsMap(a)
 	if err != nil {
 		// we have to assume they're not the same
 		return false
 	}
-	sb, err := ToMap(b)
+	sb, err := toSettingsMap(b)
 	if err != nil {
 		return false
 	}
-	if len(sa) != len(sb) {
-		return false
+	return isSameSettingValue(sa, sb)
+}
+
+// CompareSettingsWithPolicy compares the desired with the observed settings according to the given policy.
+// With exoscalev1.SettingsPolicyManagedKeysOnly only the settings that are desired are compared, see ManagedSettings.
+func CompareSettingsWithPolicy(policy exoscalev1.SettingsPolicy, desired, observed runtime.RawExtension) bool {
+	if policy == exoscalev1.SettingsPolicyManagedKeysOnly {
+		observed = ManagedSettings(desired, observed)
+	}
+	return CompareSettings(desired, observed)
+}
+
+// ManagedSettings returns the observed settings that are also present in the desired settings.
+// Nested objects are reduced to the desired keys as well, arrays are returned as observed.
+// The observed settings are returned unchanged if either settings can't be parsed.
+func ManagedSettings(desired, observed runtime.RawExtension) runtime.RawExtension {
+	sd, err := toSettingsMap(desired)
+	if err != nil {
+		return observed
+	}
+	so, err := toSettingsMap(observed)
+	if err != nil {
+		return observed
 	}
-	for k, va := range sa {
-		if sb[k] != va {
+	raw, err := json.Marshal(managedSettingValues(sd, so))
+	if err != nil {
+		return observed
+	}
+	return runtime.RawExtension{Raw: raw}
+}
+
+func managedSettingValues(desired, observed map[string]interface{}) map[string]interface{} {
+	managed := make(map[string]interface{}, len(desired))
+	for k, d := range desired {
+		o, ok := observed[k]
+		if !ok {
+			continue
+		}
+		dm, dok := d.(map[string]interface{})
+		om, ook := o.(map[string]interface{})
+		if dok && ook {
+			o = managedSettingValues(dm, om)
+		}
+		managed[k] = o
+	}
+	return managed
+}
+
+// toSettingsMap works like ToMap, but keeps numbers as json.Number to compare them without loss of precision.
+func toSettingsMap(raw runtime.RawExtension) (map[string]interface{}, error) {
+	m := make(map[string]interface{}, 0)
+	if len(raw.Raw) == 0 {
+		return m, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw.Raw))
+	dec.UseNumber()
+	err := dec.Decode(&m)
+	if m == nil {
+		// A null object is treated like an empty one.
+		m = make(map[string]interface{}, 0)
+	}
+	return m, err
+}
+
+func isSameSettingValue(a, b interface{}) bool {
+	if na, ok := toNumber(a); ok {
+		nb, ok := toNumber(b)
+		return ok && na.Cmp(nb) == 0
+	}
+	switch va := a.(type) {
+	case map[string]interface{}:
+		vb, ok := b.(map[string]interface{})
+		if !ok || len(va) != len(vb) {
+			return false
+		}
+		for k, v := range va {
+			w, ok := vb[k]
+			if !ok || !isSameSettingValue(v, w) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		vb, ok := b.([]interface{})
+		if !ok || len(va) != len(vb) {
 			return false
 		}
+		for i := range va {
+			if !isSameSettingValue(va[i], vb[i]) {
+				return false
+			}
+		}
+		return true
+	case string, bool, nil:
+		return a == b
 	}
-	return true
+	return false
+}
+
+// toNumber converts numeric setting values to an exact rational number.
+func toNumber(v interface{}) (*big.Rat, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		return new(big.Rat).SetString(n.String())
+	case float64:
+		r := new(big.Rat).SetFloat64(n)
+		return r, r != nil
+	case float32:
+		r := new(big.Rat).SetFloat64(float64(n))
+		return r, r != nil
+	case int:
+		return new(big.Rat).SetInt64(int64(n)), true
+	case int32:
+		return new(big.Rat).SetInt64(int64(n)), true
+	case int64:
+		return new(big.Rat).SetInt64(n), true
+	}
+	return nil, false
 }
 
 // CompareMajorVersion params should follow SemVer.
@@ -69,3 +229,17 @@ func CompareMajorVersion(a, b string) (bool, error) {
 	// first segment is the major version (segment order MAJOR.MINOR.PATCH)
 	return sva[0] == svb[0], nil
 }
+
+// IsMajorUpgrade returns true if the major version of desired is greater than the one of observed.
+// Params should follow SemVer, see CompareMajorVersion.
+func IsMajorUpgrade(desired, observed string) (bool, error) {
+	vd, err := version.NewVersion(desired)
+	if err != nil {
+		return false, err
+	}
+	vo, err := version.NewVersion(observed)
+	if err != nil {
+		return false, err
+	}
+	return vd.Segments()[0] > vo.Segments()[0], nil
+}
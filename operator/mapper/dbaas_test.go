@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -62,6 +63,56 @@ func TestIsSameStringSet(t *testing.T) {
 	}
 }
 
+func TestIsSameIPFilter(t *testing.T) {
+	tests := map[string]struct {
+		given    []string
+		arg      []string
+		expected bool
+	}{
+		"Same": {
+			given:    []string{"10.0.0.0/8", "192.168.1.0/24"},
+			arg:      []string{"192.168.1.0/24", "10.0.0.0/8"},
+			expected: true,
+		},
+		"AddressAndHostPrefix": {
+			given:    []string{"10.0.0.1"},
+			arg:      []string{"10.0.0.1/32"},
+			expected: true,
+		},
+		"IPv6AddressAndHostPrefix": {
+			given:    []string{"2001:db8::1"},
+			arg:      []string{"2001:db8:0::1/128"},
+			expected: true,
+		},
+		"HostBitsSet": {
+			given:    []string{"10.0.0.1/24"},
+			arg:      []string{"10.0.0.0/24"},
+			expected: true,
+		},
+		"DifferentPrefixLength": {
+			given:    []string{"10.0.0.0/24"},
+			arg:      []string{"10.0.0.0/16"},
+			expected: false,
+		},
+		"Unparseable_Same": {
+			given:    []string{"invalid"},
+			arg:      []string{"invalid"},
+			expected: true,
+		},
+		"Unparseable_Different": {
+			given:    []string{"invalid"},
+			arg:      []string{"0.0.0.0/0"},
+			expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := IsSameIPFilter(tc.given, &tc.arg)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
 func TestCompareMajorVersion(t *testing.T) {
 	tests := map[string]struct {
 		versionA      string
@@ -125,6 +176,50 @@ func TestCompareMajorVersion(t *testing.T) {
 	}
 }
 
+func TestIsMajorUpgrade(t *testing.T) {
+	tests := map[string]struct {
+		desired       string
+		observed      string
+		expectedBool  bool
+		expectedError string
+	}{
+		"same major version": {
+			desired:  "14",
+			observed: "14.5",
+		},
+		"newer major version": {
+			desired:      "15",
+			observed:     "14.5",
+			expectedBool: true,
+		},
+		"skipping major versions": {
+			desired:      "17",
+			observed:     "14",
+			expectedBool: true,
+		},
+		"older major version": {
+			desired:  "13",
+			observed: "14.5",
+		},
+		"wrong version": {
+			desired:       "random",
+			observed:      "14",
+			expectedError: "Malformed version: random",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			b, err := IsMajorUpgrade(tc.desired, tc.observed)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedBool, b)
+			}
+		})
+	}
+}
+
 func TestCompareSettings(t *testing.T) {
 	tests := map[string]struct {
 		givenSpec    string
@@ -145,6 +240,59 @@ func TestCompareSettings(t *testing.T) {
 			observedSpec: map[string]interface{}{"bool": true, "number": 0.01, "string": ""},
 			expected:     true,
 		},
+		"DifferentString": {givenSpec: `{"string":"value"}`, observedSpec: map[string]interface{}{"string": "other"}, expected: false},
+		"DifferentKey":    {givenSpec: `{"a":"value"}`, observedSpec: map[string]interface{}{"b": "value"}, expected: false},
+		"IntegerAndFloat": {givenSpec: `{"number":1.0}`, observedSpec: map[string]interface{}{"number": int64(1)}, expected: true},
+		"Exponent":        {givenSpec: `{"number":1e3}`, observedSpec: map[string]interface{}{"number": 1000}, expected: true},
+		"StringAndNumber": {givenSpec: `{"number":"1"}`, observedSpec: map[string]interface{}{"number": 1}, expected: false},
+		"NullAndZero":     {givenSpec: `{"number":null}`, observedSpec: map[string]interface{}{"number": 0}, expected: false},
+		"LargeInteger_Same": {
+			givenSpec:    `{"number":9007199254740993}`,
+			observedSpec: map[string]interface{}{"number": int64(9007199254740993)},
+			expected:     true,
+		},
+		"LargeInteger_Different": {
+			givenSpec:    `{"number":9007199254740993}`,
+			observedSpec: map[string]interface{}{"number": int64(9007199254740992)},
+			expected:     false,
+		},
+		"SameNestedObject": {
+			givenSpec:    `{"object":{"nested":{"number":2,"string":"value"}}}`,
+			observedSpec: map[string]interface{}{"object": map[string]interface{}{"nested": map[string]interface{}{"number": 2.0, "string": "value"}}},
+			expected:     true,
+		},
+		"DifferentNestedObject": {
+			givenSpec:    `{"object":{"nested":{"number":2}}}`,
+			observedSpec: map[string]interface{}{"object": map[string]interface{}{"nested": map[string]interface{}{"number": 3}}},
+			expected:     false,
+		},
+		"NestedObject_AdditionalKey": {
+			givenSpec:    `{"object":{"a":1}}`,
+			observedSpec: map[string]interface{}{"object": map[string]interface{}{"a": 1, "b": 2}},
+			expected:     false,
+		},
+		"ObjectAndScalar": {
+			givenSpec:    `{"object":{}}`,
+			observedSpec: map[string]interface{}{"object": "{}"},
+			expected:     false,
+		},
+		"SameArray": {
+			givenSpec:    `{"slice":["a",{"b":1}]}`,
+			observedSpec: map[string]interface{}{"slice": []interface{}{"a", map[string]interface{}{"b": 1}}},
+			expected:     true,
+		},
+		"EmptyArrays": {givenSpec: `{"slice":[]}`, observedSpec: map[string]interface{}{"slice": []interface{}{}}, expected: true},
+		"ArrayOrder": {
+			givenSpec:    `{"slice":["a","b"]}`,
+			observedSpec: map[string]interface{}{"slice": []interface{}{"b", "a"}},
+			expected:     false,
+		},
+		"ArrayLength": {
+			givenSpec:    `{"slice":["a"]}`,
+			observedSpec: map[string]interface{}{"slice": []interface{}{"a", "a"}},
+			expected:     false,
+		},
+		"InvalidSpec": {givenSpec: `{"key":`, observedSpec: nil, expected: false},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -157,3 +305,38 @@ func TestCompareSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestCompareSettingsWithPolicy(t *testing.T) {
+	tests := map[string]struct {
+		policy       exoscalev1.SettingsPolicy
+		givenSpec    string
+		observedSpec string
+		expected     bool
+	}{
+		"Full_Same":                {policy: exoscalev1.SettingsPolicyFull, givenSpec: `{"a":1,"b":2}`, observedSpec: `{"a":1,"b":2}`, expected: true},
+		"Full_AdditionalKey":       {policy: exoscalev1.SettingsPolicyFull, givenSpec: `{"a":1}`, observedSpec: `{"a":1,"b":2}`, expected: false},
+		"Unset_AdditionalKey":      {givenSpec: `{"a":1}`, observedSpec: `{"a":1,"b":2}`, expected: false},
+		"Managed_AdditionalKey":    {policy: exoscalev1.SettingsPolicyManagedKeysOnly, givenSpec: `{"a":1}`, observedSpec: `{"a":1.0,"b":2}`, expected: true},
+		"Managed_DifferentValue":   {policy: exoscalev1.SettingsPolicyManagedKeysOnly, givenSpec: `{"a":1}`, observedSpec: `{"a":2,"b":2}`, expected: false},
+		"Managed_MissingKey":       {policy: exoscalev1.SettingsPolicyManagedKeysOnly, givenSpec: `{"a":1,"c":3}`, observedSpec: `{"a":1,"b":2}`, expected: false},
+		"Managed_EmptySpec":        {policy: exoscalev1.SettingsPolicyManagedKeysOnly, givenSpec: ``, observedSpec: `{"a":1}`, expected: true},
+		"Managed_NestedAdditional": {policy: exoscalev1.SettingsPolicyManagedKeysOnly, givenSpec: `{"o":{"a":1}}`, observedSpec: `{"o":{"a":1,"b":2},"c":3}`, expected: true},
+		"Managed_NestedDifferent":  {policy: exoscalev1.SettingsPolicyManagedKeysOnly, givenSpec: `{"o":{"a":1}}`, observedSpec: `{"o":{"a":2,"b":2}}`, expected: false},
+		"Managed_ArrayAdditional":  {policy: exoscalev1.SettingsPolicyManagedKeysOnly, givenSpec: `{"s":["a"]}`, observedSpec: `{"s":["a","b"]}`, expected: false},
+		"Managed_ObjectAndScalar":  {policy: exoscalev1.SettingsPolicyManagedKeysOnly, givenSpec: `{"o":{"a":1}}`, observedSpec: `{"o":"a"}`, expected: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := CompareSettingsWithPolicy(tc.policy, runtime.RawExtension{Raw: []byte(tc.givenSpec)}, runtime.RawExtension{Raw: []byte(tc.observedSpec)})
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestManagedSettings(t *testing.T) {
+	result := ManagedSettings(
+		runtime.RawExtension{Raw: []byte(`{"a":1,"o":{"b":true},"missing":"x"}`)},
+		runtime.RawExtension{Raw: []byte(`{"a":9007199254740993,"o":{"b":false,"c":1},"d":"e"}`)},
+	)
+	assert.Equal(t, `{"a":9007199254740993,"o":{"b":false}}`, string(result.Raw))
+}
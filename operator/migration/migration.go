@@ -0,0 +1,169 @@
+// Package migration migrates data from existing database servers into DBaaS services.
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Client is the part of the Exoscale client needed to observe migrations.
+type Client interface {
+	GetDBAASMigrationStatus(ctx context.Context, name string) (*exoscalesdk.DBAASMigrationStatus, error)
+}
+
+// AppliedAnnotation records the migration sent to the provider on creation.
+// Status changes made on creation are lost, hence the migration is moved into the status once observed.
+const AppliedAnnotation = "exoscale.crossplane.io/applied-migration"
+
+// Request is the migration part of a create request.
+// The migration parts of the other create and update requests share the same underlying type and can be converted.
+type Request = exoscalesdk.CreateDBAASServicePGRequestMigration
+
+// Observe returns the observed state of the migration of the given service and whether it matches the given spec.
+// The status isn't polled anymore once the migration has been removed from the spec and stopped.
+func Observe(ctx context.Context, kube client.Client, c Client, serviceName string, spec *exoscalev1.MigrationSpec, previous *exoscalev1.MigrationObservation) (*exoscalev1.MigrationObservation, bool, error) {
+	if spec == nil && (previous == nil || previous.Applied == nil) {
+		return previous, true, nil
+	}
+	observed := &exoscalev1.MigrationObservation{}
+	if previous != nil {
+		observed.Applied = previous.Applied
+		observed.CredentialsVersion = previous.CredentialsVersion
+	}
+
+	status, err := c.GetDBAASMigrationStatus(ctx, serviceName)
+	if err != nil && !errors.Is(err, exoscalesdk.ErrNotFound) {
+		return previous, false, fmt.Errorf("cannot get migration status: %w", err)
+	}
+	if status != nil {
+		observed.Phase = status.Status
+		observed.Method = status.Method
+		observed.Error = status.Error
+		observed.Details = toDetails(status.Details)
+	}
+
+	version := ""
+	if spec != nil {
+		_, version, err = fetchCredentials(ctx, kube, spec.CredentialsSecretRef)
+		if err != nil {
+			return observed, false, err
+		}
+	}
+	return observed, IsUpToDate(spec, version, observed), nil
+}
+
+// IsUpToDate returns true if the given spec and credentials version have been sent to the provider.
+func IsUpToDate(spec *exoscalev1.MigrationSpec, version string, observed *exoscalev1.MigrationObservation) bool {
+	if observed == nil || observed.Applied == nil {
+		return spec == nil
+	}
+	if spec == nil {
+		return false
+	}
+	return reflect.DeepEqual(*spec, *observed.Applied) && version == observed.CredentialsVersion
+}
+
+// ToRequest returns the migration part of a request and the resource version of the credentials secret.
+// Returns nil if no migration is given.
+func ToRequest(ctx context.Context, kube client.Client, spec *exoscalev1.MigrationSpec) (*Request, string, error) {
+	if spec == nil {
+		return nil, "", nil
+	}
+	creds, version, err := fetchCredentials(ctx, kube, spec.CredentialsSecretRef)
+	if err != nil {
+		return nil, "", err
+	}
+	return &Request{
+		Host:      spec.Host,
+		Port:      spec.Port,
+		Dbname:    spec.DBName,
+		Method:    spec.Method,
+		IgnoreDbs: strings.Join(spec.IgnoreDBs, ","),
+		SSL:       spec.SSL,
+		Username:  string(creds[exoscalev1.MigrationUsernameKey]),
+		Password:  string(creds[exoscalev1.MigrationPasswordKey]),
+	}, version, nil
+}
+
+// Applied returns the observed state after the given spec has been sent to the provider.
+// Removes the configuration if spec is nil, which is the case once the migration has been stopped.
+func Applied(observed *exoscalev1.MigrationObservation, spec *exoscalev1.MigrationSpec, version string) *exoscalev1.MigrationObservation {
+	applied := &exoscalev1.MigrationObservation{}
+	if observed != nil {
+		applied = observed.DeepCopy()
+	}
+	applied.Applied = spec.DeepCopy()
+	applied.CredentialsVersion = version
+	return applied
+}
+
+// Record records the given spec and credentials version as sent to the provider on creation.
+func Record(obj metav1.Object, spec *exoscalev1.MigrationSpec, version string) error {
+	if spec == nil {
+		return nil
+	}
+	raw, err := json.Marshal(Applied(nil, spec, version))
+	if err != nil {
+		return fmt.Errorf("cannot record migration: %w", err)
+	}
+	meta.AddAnnotations(obj, map[string]string{AppliedAnnotation: string(raw)})
+	return nil
+}
+
+// Recorded returns the given previously observed state of the migration.
+// Falls back to the migration recorded on creation if the migration hasn't been observed yet.
+func Recorded(obj metav1.Object, previous *exoscalev1.MigrationObservation) (*exoscalev1.MigrationObservation, error) {
+	raw, ok := obj.GetAnnotations()[AppliedAnnotation]
+	if previous != nil || !ok {
+		return previous, nil
+	}
+	recorded := &exoscalev1.MigrationObservation{}
+	err := json.Unmarshal([]byte(raw), recorded)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse recorded migration: %w", err)
+	}
+	return recorded, nil
+}
+
+// fetchCredentials returns the data and resource version of the credentials secret.
+// Returns empty credentials if no secret is referenced.
+func fetchCredentials(ctx context.Context, kube client.Client, ref *xpv1.SecretReference) (map[string][]byte, string, error) {
+	if ref == nil {
+		return map[string][]byte{}, "", nil
+	}
+	secret := &corev1.Secret{}
+	err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot get migration credentials secret: %w", err)
+	}
+	return secret.Data, secret.ResourceVersion, nil
+}
+
+func toDetails(details []exoscalesdk.DBAASMigrationStatusDetails) []exoscalev1.MigrationDetail {
+	if details == nil {
+		return nil
+	}
+	s := make([]exoscalev1.MigrationDetail, len(details))
+	for i, d := range details {
+		s[i] = exoscalev1.MigrationDetail{
+			DBName: d.Dbname,
+			Method: d.Method,
+			Status: d.Status,
+			Error:  d.Error,
+		}
+	}
+	return s
+}
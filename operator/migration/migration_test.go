@@ -0,0 +1,130 @@
+package migration
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	spec := &exoscalev1.MigrationSpec{
+		Host:                 "db.example.com",
+		Port:                 5432,
+		Method:               "replication",
+		CredentialsSecretRef: &xpv1.SecretReference{Name: "creds", Namespace: "default"},
+	}
+	changed := spec.DeepCopy()
+	changed.IgnoreDBs = []string{"defaultdb"}
+
+	tests := map[string]struct {
+		givenSpec     *exoscalev1.MigrationSpec
+		givenVersion  string
+		givenObserved *exoscalev1.MigrationObservation
+		expected      bool
+	}{
+		"NoMigration": {
+			expected: true,
+		},
+		"NotAppliedYet": {
+			givenSpec:     spec,
+			givenVersion:  "1",
+			givenObserved: &exoscalev1.MigrationObservation{Phase: "running"},
+			expected:      false,
+		},
+		"Applied": {
+			givenSpec:     spec,
+			givenVersion:  "1",
+			givenObserved: &exoscalev1.MigrationObservation{Applied: spec.DeepCopy(), CredentialsVersion: "1"},
+			expected:      true,
+		},
+		"SpecChanged": {
+			givenSpec:     changed,
+			givenVersion:  "1",
+			givenObserved: &exoscalev1.MigrationObservation{Applied: spec.DeepCopy(), CredentialsVersion: "1"},
+			expected:      false,
+		},
+		"CredentialsChanged": {
+			givenSpec:     spec,
+			givenVersion:  "2",
+			givenObserved: &exoscalev1.MigrationObservation{Applied: spec.DeepCopy(), CredentialsVersion: "1"},
+			expected:      false,
+		},
+		"Removed": {
+			givenObserved: &exoscalev1.MigrationObservation{Applied: spec.DeepCopy(), CredentialsVersion: "1"},
+			expected:      false,
+		},
+		"Stopped": {
+			givenObserved: &exoscalev1.MigrationObservation{Phase: "done"},
+			expected:      true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsUpToDate(tc.givenSpec, tc.givenVersion, tc.givenObserved))
+		})
+	}
+}
+
+func TestApplied(t *testing.T) {
+	spec := &exoscalev1.MigrationSpec{Host: "db.example.com", Port: 3306}
+	observed := &exoscalev1.MigrationObservation{Phase: "running", Method: "dump"}
+
+	applied := Applied(observed, spec, "1")
+	assert.Equal(t, &exoscalev1.MigrationObservation{Applied: spec, CredentialsVersion: "1", Phase: "running", Method: "dump"}, applied)
+	assert.Nil(t, observed.Applied, "observed state must not be modified")
+
+	stopped := Applied(applied, nil, "")
+	assert.Equal(t, &exoscalev1.MigrationObservation{Phase: "running", Method: "dump"}, stopped)
+}
+
+// fakeClient reports the given migration status.
+type fakeClient struct {
+	status *exoscalesdk.DBAASMigrationStatus
+}
+
+func (c fakeClient) GetDBAASMigrationStatus(_ context.Context, _ string) (*exoscalesdk.DBAASMigrationStatus, error) {
+	return c.status, nil
+}
+
+func TestRecorded_CreateObserveUpdate(t *testing.T) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default"}}
+	kube := fake.NewClientBuilder().WithObjects(secret).Build()
+	spec := &exoscalev1.MigrationSpec{
+		Host:                 "db.example.com",
+		Port:                 5432,
+		Method:               "replication",
+		CredentialsSecretRef: &xpv1.SecretReference{Name: "creds", Namespace: "default"},
+	}
+	pgInstance := &exoscalev1.PostgreSQL{}
+	pgInstance.Spec.ForProvider.Migration = spec
+
+	// Create
+	_, version, err := ToRequest(context.TODO(), kube, spec)
+	require.NoError(t, err)
+	require.NoError(t, Record(pgInstance, spec, version))
+	assert.Nil(t, pgInstance.Status.AtProvider.Migration, "status must not be set on creation")
+
+	// Observe
+	previous, err := Recorded(pgInstance, pgInstance.Status.AtProvider.Migration)
+	require.NoError(t, err)
+	observed, upToDate, err := Observe(context.TODO(), kube, fakeClient{status: &exoscalesdk.DBAASMigrationStatus{Status: "running"}}, "service", spec, previous)
+	require.NoError(t, err)
+	assert.True(t, upToDate, "migration sent on creation must not be sent again")
+	assert.Equal(t, spec, observed.Applied)
+	assert.Equal(t, "running", observed.Phase)
+	pgInstance.Status.AtProvider.Migration = observed
+
+	// Update after the migration has been stopped
+	pgInstance.Status.AtProvider.Migration = Applied(observed, nil, "")
+	previous, err = Recorded(pgInstance, pgInstance.Status.AtProvider.Migration)
+	require.NoError(t, err)
+	assert.Nil(t, previous.Applied, "recorded migration must not be restored once observed")
+}
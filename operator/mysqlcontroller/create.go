@@ -12,6 +12,7 @@ import (
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
 
 	"github.com/vshn/provider-exoscale/operator/mapper"
+	"github.com/vshn/provider-exoscale/operator/migration"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 )
 
@@ -50,7 +51,18 @@ func (p *pipeline) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		Plan:                  spec.Size.Plan,
 		IPFilter:              ipFilter,
 		MysqlSettings:         settings,
+		ForkFromService:       exoscalesdk.DBAASServiceName(spec.ForkFrom),
+		Integrations:          toReadReplicaIntegrations(spec.ReadReplicaOf),
 	}
+	body.RecoveryBackupTime, err = p.getRecoveryBackupTime(ctx, spec)
+	if err != nil {
+		return managed.ExternalCreation{}, fmt.Errorf("cannot determine backup to recover from: %w", err)
+	}
+	migrationReq, migrationVersion, err := migration.ToRequest(ctx, p.kube, spec.Migration)
+	if err != nil {
+		return managed.ExternalCreation{}, fmt.Errorf("cannot map mySQLInstance migration: %w", err)
+	}
+	body.Migration = (*exoscalesdk.CreateDBAASServiceMysqlRequestMigration)(migrationReq)
 	resp, err := p.exo.CreateDBAASServiceMysql(ctx, mySQLInstance.GetInstanceName(), body)
 	if err != nil {
 		if strings.Contains(err.Error(), "Service name is already taken") {
@@ -62,5 +74,34 @@ func (p *pipeline) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	log.V(1).Info("response", "message", resp.Message)
+	err = migration.Record(mySQLInstance, spec.Migration, migrationVersion)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	return managed.ExternalCreation{}, nil
 }
+
+// toReadReplicaIntegrations returns the integration that creates the instance as read replica of the given service.
+// Read replicas can only be set up when the instance is created.
+func toReadReplicaIntegrations(source string) []exoscalesdk.CreateDBAASServiceMysqlRequestIntegrations {
+	if source == "" {
+		return nil
+	}
+	return []exoscalesdk.CreateDBAASServiceMysqlRequestIntegrations{{
+		Type:          exoscalesdk.CreateDBAASServiceMysqlRequestIntegrationsTypeReadReplica,
+		SourceService: exoscalesdk.DBAASServiceName(source),
+	}}
+}
+
+// getRecoveryBackupTime returns the time of the backup to recover from when forking another instance.
+// The backups of the forked instance are only looked up if a backup name is given.
+func (p *pipeline) getRecoveryBackupTime(ctx context.Context, spec exoscalev1.MySQLParameters) (string, error) {
+	if spec.RecoveryBackupName == "" {
+		return mapper.ToRecoveryBackupTime(nil, "", spec.RecoveryTargetTime)
+	}
+	source, err := p.exo.GetDBAASServiceMysql(ctx, spec.ForkFrom)
+	if err != nil {
+		return "", fmt.Errorf("cannot get forked instance %q: %w", spec.ForkFrom, err)
+	}
+	return mapper.ToRecoveryBackupTime(source.Backups, spec.RecoveryBackupName, spec.RecoveryTargetTime)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -15,7 +16,11 @@ import (
 
 	"github.com/go-logr/logr"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/externalendpoint"
 	"github.com/vshn/provider-exoscale/operator/mapper"
+	"github.com/vshn/provider-exoscale/operator/migration"
+	"github.com/vshn/provider-exoscale/operator/notification"
+	"github.com/vshn/provider-exoscale/operator/trigger"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 )
 
@@ -36,10 +41,17 @@ func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	log.V(1).Info("retrieved mySQLInstance", "state", mysql.State)
 
+	previousMigration, err := migration.Recorded(mySQLInstance, mySQLInstance.Status.AtProvider.Migration)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	previousTriggers := mySQLInstance.Status.AtProvider.Triggers
+	previousNotifications := mySQLInstance.Status.AtProvider.Notifications
 	mySQLInstance.Status.AtProvider, err = mapObservation(mysql)
 	if err != nil {
 		log.Error(err, "cannot map mySQLInstance observation, ignoring")
 	}
+	notification.RecordWarnings(p.recorder, mySQLInstance, previousNotifications, mySQLInstance.Status.AtProvider.Notifications)
 	var state exoscalesdk.EnumServiceState
 	if mysql.State != "" {
 		state = mysql.State
@@ -56,6 +68,7 @@ func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	default:
 		log.V(2).Info("ignoring unknown mySQLInstance state", "state", state)
 	}
+	mapper.SetBackupCondition(&mySQLInstance.Status.ConditionedStatus, mySQLInstance.Status.AtProvider.LastBackupTime, mysql.CreatedAT, mySQLInstance.Spec.ForProvider.MaxBackupAge, time.Now())
 
 	caCert, err := p.exo.GetDBAASCACertificate(ctx)
 	if err != nil {
@@ -71,15 +84,33 @@ func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, fmt.Errorf("cannot parse parameters: %w", err)
 	}
-	currentParams, err := setSettingsDefaults(ctx, *p.exo, &mySQLInstance.Spec.ForProvider)
+	currentParams := &mySQLInstance.Spec.ForProvider
+	if currentParams.SettingsPolicy != exoscalev1.SettingsPolicyManagedKeysOnly {
+		// Unset settings are expected to have their default value.
+		currentParams, err = setSettingsDefaults(ctx, *p.exo, &mySQLInstance.Spec.ForProvider)
+		if err != nil {
+			log.Error(err, "unable to set mysql settings schema")
+			currentParams = &mySQLInstance.Spec.ForProvider
+		}
+	}
+
+	endpoints, endpointsUpToDate, err := externalendpoint.Observe(ctx, p.kube, p.exo, &mySQLInstance.Status.ConditionedStatus, mySQLInstance.GetInstanceName(), mySQLInstance.Spec.ForProvider.ExternalEndpoints)
 	if err != nil {
-		log.Error(err, "unable to set mysql settings schema")
-		currentParams = &mySQLInstance.Spec.ForProvider
+		return managed.ExternalObservation{}, err
 	}
+	mySQLInstance.Status.AtProvider.ExternalEndpoints = endpoints
+
+	migrationStatus, migrationUpToDate, err := migration.Observe(ctx, p.kube, p.exo, mySQLInstance.GetInstanceName(), mySQLInstance.Spec.ForProvider.Migration, previousMigration)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	mySQLInstance.Status.AtProvider.Migration = migrationStatus
+
+	mySQLInstance.Status.AtProvider.Triggers = previousTriggers
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  isUpToDate(currentParams, params, log),
+		ResourceUpToDate:  isUpToDate(currentParams, params, log) && endpointsUpToDate && migrationUpToDate && trigger.IsUpToDate(mySQLInstance, previousTriggers),
 		ConnectionDetails: connDetails,
 	}, nil
 }
@@ -126,10 +157,10 @@ func isUpToDate(current, external *exoscalev1.MySQLParameters, log logr.Logger)
 		"Backup":                current.Backup.TimeOfDay == external.Backup.TimeOfDay,
 		"Zone":                  current.Zone == external.Zone,
 		"Version":               hasSameMajorVersion,
-		"IPFilter":              mapper.IsSameStringSet(current.IPFilter, &extIPFilter),
+		"IPFilter":              mapper.IsSameIPFilter(current.IPFilter, &extIPFilter),
 		"Size":                  current.Size.Equals(external.Size),
 		"TerminationProtection": current.TerminationProtection == external.TerminationProtection,
-		"MySQLSettings":         mapper.CompareSettings(current.MySQLSettings, external.MySQLSettings),
+		"MySQLSettings":         mapper.CompareSettingsWithPolicy(current.SettingsPolicy, current.MySQLSettings, external.MySQLSettings),
 	}
 	ok := true
 	for _, v := range checks {
@@ -158,6 +189,7 @@ func mapObservation(instance *exoscalesdk.DBAASServiceMysql) (exoscalev1.MySQLOb
 		Version:    instance.Version,
 		NodeStates: nodeStates,
 	}
+	observation.Backups, observation.LastBackupTime = mapper.ToBackups(instance.Backups)
 
 	observation.MySQLSettings = settings
 
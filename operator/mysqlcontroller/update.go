@@ -9,8 +9,11 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	exoscalesdk "github.com/exoscale/egoscale/v3"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/trigger"
 
+	"github.com/vshn/provider-exoscale/operator/externalendpoint"
 	"github.com/vshn/provider-exoscale/operator/mapper"
+	"github.com/vshn/provider-exoscale/operator/migration"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 )
 
@@ -21,6 +24,10 @@ func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	mySQLInstance := mg.(*exoscalev1.MySQL)
 
+	mySQLInstance.Status.AtProvider.Triggers = trigger.Handle(ctx, p.recorder, mySQLInstance, mySQLInstance.GetInstanceName(), trigger.Actions{
+		trigger.MaintenanceAnnotation: p.exo.StartDBAASMysqlMaintenance,
+	}, mySQLInstance.Status.AtProvider.Triggers)
+
 	spec := mySQLInstance.Spec.ForProvider
 	ipFilter := []string(spec.IPFilter)
 	settings := exoscalesdk.JSONSchemaMysql{}
@@ -49,10 +56,33 @@ func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		IPFilter:              ipFilter,
 		MysqlSettings:         settings,
 	}
+	observedMigration := mySQLInstance.Status.AtProvider.Migration
+	migrationReq, migrationVersion, err := migration.ToRequest(ctx, p.kube, spec.Migration)
+	if err != nil {
+		return managed.ExternalUpdate{}, fmt.Errorf("cannot map mySQLInstance migration: %w", err)
+	}
+	if spec.Migration != nil && !migration.IsUpToDate(spec.Migration, migrationVersion, observedMigration) {
+		// Only send the migration if it changed, as it restarts the migration.
+		body.Migration = (*exoscalesdk.UpdateDBAASServiceMysqlRequestMigration)(migrationReq)
+	}
 	resp, err := p.exo.UpdateDBAASServiceMysql(ctx, mySQLInstance.GetInstanceName(), body)
 	if err != nil {
 		return managed.ExternalUpdate{}, fmt.Errorf("cannot update mySQLInstance: %w", err)
 	}
 	log.V(1).Info("response", "message", string(resp.Message))
+	if body.Migration != nil {
+		mySQLInstance.Status.AtProvider.Migration = migration.Applied(observedMigration, spec.Migration, migrationVersion)
+	}
+	if spec.Migration == nil && !migration.IsUpToDate(nil, "", observedMigration) {
+		_, err = p.exo.StopDBAASMysqlMigration(ctx, mySQLInstance.GetInstanceName())
+		if err != nil {
+			return managed.ExternalUpdate{}, fmt.Errorf("cannot stop mySQLInstance migration: %w", err)
+		}
+		mySQLInstance.Status.AtProvider.Migration = migration.Applied(observedMigration, nil, "")
+	}
+	err = externalendpoint.Update(ctx, p.kube, p.exo, mySQLInstance.GetInstanceName(), spec.ExternalEndpoints, mySQLInstance.Status.AtProvider.ExternalEndpoints)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 	return managed.ExternalUpdate{}, nil
 }
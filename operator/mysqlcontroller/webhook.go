@@ -13,6 +13,7 @@ import (
 	"github.com/vshn/provider-exoscale/operator/pipelineutil"
 	"github.com/vshn/provider-exoscale/operator/webhook"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -33,40 +34,61 @@ func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (adm
 
 	v.log.V(1).Info("validate create")
 
-	availableVersions, err := v.getAvailableVersions(ctx, obj)
+	dbaasType, err := v.getServiceType(ctx, obj)
 	if err != nil {
 		return nil, err
 	}
 
-	err = v.validateVersion(ctx, obj, availableVersions)
+	err = v.validateVersion(ctx, obj, dbaasType.AvailableVersions)
 	if err != nil {
 		return nil, err
 	}
 
-	return nil, validateSpec(mySQLInstance)
+	err = webhook.ValidatePlan(mySQLInstance.Spec.ForProvider.Size.Plan, mySQLInstance.Spec.ForProvider.Zone.String(), dbaasType.Plans)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateIpFilter(mySQLInstance.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	err = validateSpec(mySQLInstance)
+	if err != nil {
+		return nil, err
+	}
+	return nil, v.validateSettingsSchema(ctx, nil, mySQLInstance)
 }
 
-func (v *Validator) getAvailableVersions(ctx context.Context, obj runtime.Object) ([]string, error) {
+func (v *Validator) getServiceType(ctx context.Context, obj runtime.Object) (*exoscalesdk.DBAASServiceType, error) {
 	mySQLInstance := obj.(*exoscalev1.MySQL)
 
-	v.log.V(1).Info("get mysql available versions")
+	v.log.V(1).Info("get mysql service type")
 	exo, err := pipelineutil.OpenExoscaleClient(ctx, v.kube, mySQLInstance.GetProviderConfigName(), exoscalesdk.ClientOptWithEndpoint(common.ZoneTranslation[mySQLInstance.Spec.ForProvider.Zone]))
 	if err != nil {
 		return nil, fmt.Errorf("open exoscale client failed: %w", err)
 	}
 
-	// get mysql available versions
 	resp, err := exo.Exoscale.GetDBAASServiceType(ctx, serviceType)
 	if err != nil {
 		return nil, fmt.Errorf("get DBaaS service type failed: %w", err)
 	}
 
 	v.log.V(1).Info("DBaaS service type", "name", string(resp.Name), "description", string(resp.Description))
+	return resp, nil
+}
 
-	if resp.AvailableVersions == nil {
-		return nil, fmt.Errorf("mysql available versions not found")
+// validatePlanChange validates a changed plan and warns about downgrades.
+func (v *Validator) validatePlanChange(ctx context.Context, oldInst, newInst exoscalev1.MySQL) (admission.Warnings, error) {
+	oldPlan, newPlan := oldInst.Spec.ForProvider.Size.Plan, newInst.Spec.ForProvider.Size.Plan
+	if oldPlan == newPlan {
+		return nil, nil
 	}
-	return resp.AvailableVersions, nil
+	dbaasType, err := v.getServiceType(ctx, &newInst)
+	if err != nil {
+		return nil, err
+	}
+	return webhook.ValidatePlanChange(oldPlan, newPlan, newInst.Spec.ForProvider.Zone.String(), dbaasType.Plans)
 }
 
 func (v *Validator) validateVersion(_ context.Context, obj runtime.Object, availableVersions []string) error {
@@ -77,7 +99,7 @@ func (v *Validator) validateVersion(_ context.Context, obj runtime.Object, avail
 }
 
 // ValidateUpdate implements admission.CustomValidator.
-func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	newInstance, ok := newObj.(*exoscalev1.MySQL)
 	if !ok {
 		return nil, fmt.Errorf("invalid managed resource type %T for mysql webhook", newObj)
@@ -88,24 +110,70 @@ func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Obj
 	}
 	v.log.V(1).Info("validate update")
 
-	err := validateSpec(newInstance)
+	err := webhook.ValidateIPFilterUpdate(oldInstance.Spec.ForProvider.IPFilter, newInstance.Spec.ForProvider.IPFilter)
+	if err != nil {
+		return nil, err
+	}
+	err = validateSpec(newInstance)
+	if err != nil {
+		return nil, err
+	}
+	err = validateImmutable(*oldInstance, *newInstance)
+	if err != nil {
+		return nil, err
+	}
+	err = v.validateSettingsSchema(ctx, oldInstance, newInstance)
 	if err != nil {
 		return nil, err
 	}
-	return nil, validateImmutable(*oldInstance, *newInstance)
+	return v.validatePlanChange(ctx, *oldInstance, *newInstance)
 }
 
 // ValidateDelete implements admission.CustomValidator.
-func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
-	v.log.V(1).Info("validate delete (noop)")
-	return nil, nil
+func (v *Validator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	instance, ok := obj.(*exoscalev1.MySQL)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for mysql webhook", obj)
+	}
+	v.log.V(1).Info("validate delete")
+	return nil, webhook.ValidateTerminationProtection(instance, instance.Spec.ForProvider.TerminationProtection, instance.Status.AtProvider.TerminationProtection)
+}
+
+// validateSettingsSchema validates new or changed settings against the settings schema of the service.
+// oldInst is nil on creation.
+func (v *Validator) validateSettingsSchema(ctx context.Context, oldInst, newInst *exoscalev1.MySQL) error {
+	var previous []webhook.Setting
+	if oldInst != nil {
+		previous = settingsOf(oldInst.Spec.ForProvider)
+	}
+	changed := webhook.ChangedSettings(previous, settingsOf(newInst.Spec.ForProvider))
+	if len(changed) == 0 {
+		return nil
+	}
+	exo, err := pipelineutil.OpenExoscaleClient(ctx, v.kube, newInst.GetProviderConfigName(), exoscalesdk.ClientOptWithEndpoint(common.ZoneTranslation[newInst.Spec.ForProvider.Zone]))
+	if err != nil {
+		return fmt.Errorf("open exoscale client failed: %w", err)
+	}
+	schemas, err := fetchSettingSchema(ctx, exo.Exoscale)
+	if err != nil {
+		return fmt.Errorf("cannot fetch settings schema: %w", err)
+	}
+	return webhook.ValidateSettings(schemas, changed)
+}
+
+func settingsOf(obj exoscalev1.MySQLParameters) []webhook.Setting {
+	path := field.NewPath("spec", "forProvider")
+	return []webhook.Setting{
+		{Schema: "mysql", Path: path.Child("mysqlSettings"), Value: obj.MySQLSettings},
+	}
 }
 
 func validateSpec(obj *exoscalev1.MySQL) error {
 	for _, validatorFn := range []func(exoscalev1.MySQLParameters) error{
-		validateIpFilter,
 		validateMaintenanceSchedule,
 		validateSettings,
+		validateRecovery,
+		validateMigration,
 	} {
 		if err := validatorFn(obj.Spec.ForProvider); err != nil {
 			return err
@@ -115,10 +183,7 @@ func validateSpec(obj *exoscalev1.MySQL) error {
 }
 
 func validateIpFilter(obj exoscalev1.MySQLParameters) error {
-	if len(obj.IPFilter) == 0 {
-		return fmt.Errorf("IP filter cannot be empty")
-	}
-	return nil
+	return webhook.ValidateIPFilter(obj.IPFilter)
 }
 
 func validateMaintenanceSchedule(obj exoscalev1.MySQLParameters) error {
@@ -132,11 +197,33 @@ func validateSettings(obj exoscalev1.MySQLParameters) error {
 	return webhook.ValidateRawExtension(obj.MySQLSettings)
 }
 
+func validateRecovery(obj exoscalev1.MySQLParameters) error {
+	return webhook.ValidateRecovery(toRecovery(obj))
+}
+
+func validateMigration(obj exoscalev1.MySQLParameters) error {
+	return webhook.ValidateMigration(obj.Migration)
+}
+
+func toRecovery(obj exoscalev1.MySQLParameters) webhook.Recovery {
+	return webhook.Recovery{
+		ForkFrom:           obj.ForkFrom,
+		ForkFromReferenced: obj.ForkFromRef != nil || obj.ForkFromSelector != nil,
+		BackupName:         obj.RecoveryBackupName,
+		TargetTime:         obj.RecoveryTargetTime,
+		ReadReplicaOf:      obj.ReadReplicaOf,
+	}
+}
+
 func validateImmutable(oldInst, newInst exoscalev1.MySQL) error {
 	err := compareZone(oldInst.Spec.ForProvider, newInst.Spec.ForProvider)
 	if err != nil {
 		return err
 	}
+	err = webhook.ValidateRecoveryImmutable(toRecovery(oldInst.Spec.ForProvider), toRecovery(newInst.Spec.ForProvider))
+	if err != nil {
+		return err
+	}
 	return compareVersion(oldInst, newInst)
 }
 
@@ -0,0 +1,36 @@
+package mysqldatabasecontroller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *pipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	database := mg.(*exoscalev1.MySQLDatabase)
+	spec := database.Spec.ForProvider
+
+	resp, err := p.exo.CreateDBAASMysqlDatabase(ctx, spec.InstanceName, exoscalesdk.CreateDBAASMysqlDatabaseRequest{
+		DatabaseName: exoscalesdk.DBAASDatabaseName(database.GetDatabaseName()),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			// According to the ExternalClient Interface, create needs to be idempotent.
+			// However the exoscale client doesn't return very helpful errors, so we need to make this brittle matching to find if we get an already exits error
+			return managed.ExternalCreation{}, nil
+		}
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create database")
+	}
+	log.V(1).Info("Response", "message", resp.Message)
+	return managed.ExternalCreation{}, nil
+}
@@ -0,0 +1,52 @@
+package mysqldatabasecontroller
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+// Observe implements managed.ExternalClient.
+// A database has no configurable properties, so an existing database is always up-to-date.
+func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	database := mg.(*exoscalev1.MySQLDatabase)
+
+	mysql, err := p.exo.GetDBAASServiceMysql(ctx, database.Spec.ForProvider.InstanceName)
+	if err != nil {
+		if errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, fmt.Errorf("cannot observe MySQL instance: %w", err)
+	}
+
+	if !hasDatabase(mysql.Databases, database.GetDatabaseName()) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	database.Status.AtProvider.DatabaseName = database.GetDatabaseName()
+
+	database.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func hasDatabase(databases []exoscalesdk.DBAASMysqlDatabaseName, name string) bool {
+	for _, db := range databases {
+		if string(db) == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package mysqldatabasecontroller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/webhook"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Validator validates admission requests.
+type Validator struct {
+	log logr.Logger
+}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	database, ok := obj.(*exoscalev1.MySQLDatabase)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for mysql database webhook", obj)
+	}
+	v.log.V(1).Info("Validate create", "name", database.Name)
+
+	return nil, validateSpec(database.Spec.ForProvider)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	newDatabase, ok := newObj.(*exoscalev1.MySQLDatabase)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for mysql database webhook", newObj)
+	}
+	oldDatabase, ok := oldObj.(*exoscalev1.MySQLDatabase)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for mysql database webhook", oldObj)
+	}
+	v.log.V(1).Info("Validate update", "name", newDatabase.Name)
+
+	err := validateSpec(newDatabase.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	return nil, validateImmutable(oldDatabase.Spec.ForProvider, newDatabase.Spec.ForProvider)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *Validator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	database, ok := obj.(*exoscalev1.MySQLDatabase)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for mysql database webhook", obj)
+	}
+	v.log.V(1).Info("Validate delete", "name", database.Name)
+	return nil, webhook.ValidateTerminationProtection(database, ptr.Deref(database.Spec.ForProvider.DeletionProtection, true), false)
+}
+
+func validateSpec(params exoscalev1.MySQLDatabaseParameters) error {
+	if params.InstanceName == "" && params.InstanceRef == nil && params.InstanceSelector == nil {
+		return fmt.Errorf("one of instanceName, instanceRef or instanceSelector is required")
+	}
+	return nil
+}
+
+func validateImmutable(oldParams, newParams exoscalev1.MySQLDatabaseParameters) error {
+	if oldParams.Zone != newParams.Zone {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.Zone, newParams.Zone)
+	}
+	if oldParams.InstanceName != "" && oldParams.InstanceName != newParams.InstanceName {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.InstanceName, newParams.InstanceName)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package mysqldatabasecontroller
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/webhook"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateSpec(t *testing.T) {
+	tests := map[string]struct {
+		params        exoscalev1.MySQLDatabaseParameters
+		expectedError string
+	}{
+		"InstanceName": {
+			params: exoscalev1.MySQLDatabaseParameters{InstanceName: "pg"},
+		},
+		"InstanceRef": {
+			params: exoscalev1.MySQLDatabaseParameters{InstanceRef: &xpv1.Reference{Name: "pg"}},
+		},
+		"NoInstance": {
+			params:        exoscalev1.MySQLDatabaseParameters{},
+			expectedError: "one of instanceName, instanceRef or instanceSelector is required",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateSpec(tc.params)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateImmutable(t *testing.T) {
+	base := exoscalev1.MySQLDatabaseParameters{Zone: "ch-dk-2", InstanceName: "pg"}
+	tests := map[string]struct {
+		modify        func(p *exoscalev1.MySQLDatabaseParameters)
+		expectedError string
+	}{
+		"NoChange": {
+			modify: func(p *exoscalev1.MySQLDatabaseParameters) {},
+		},
+		"DeletionProtection": {
+			modify: func(p *exoscalev1.MySQLDatabaseParameters) { p.DeletionProtection = ptr.To(false) },
+		},
+		"Instance": {
+			modify:        func(p *exoscalev1.MySQLDatabaseParameters) { p.InstanceName = "other" },
+			expectedError: "field is immutable: pg (old), other (changed)",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			newParams := base
+			tc.modify(&newParams)
+			err := validateImmutable(base, newParams)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDelete(t *testing.T) {
+	tests := map[string]struct {
+		protected     *bool
+		annotations   map[string]string
+		expectedError bool
+	}{
+		"Default":           {expectedError: true},
+		"Unprotected":       {protected: ptr.To(false)},
+		"Protected":         {protected: ptr.To(true), expectedError: true},
+		"Protected_Allowed": {protected: ptr.To(true), annotations: map[string]string{webhook.AllowDeletionAnnotation: "true"}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			database := &exoscalev1.MySQLDatabase{}
+			database.Spec.ForProvider.DeletionProtection = tc.protected
+			database.SetAnnotations(tc.annotations)
+			v := &Validator{log: logr.Discard()}
+			_, err := v.ValidateDelete(context.Background(), database)
+			assert.Equal(t, tc.expectedError, err != nil, err)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package mysqlusercontroller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/pipelineutil"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	user := mg.(*exoscalev1.MySQLUser)
+
+	mysql, err := p.exo.GetDBAASServiceMysql(ctx, user.Spec.ForProvider.InstanceName)
+	if err != nil {
+		if errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, fmt.Errorf("cannot observe MySQL instance: %w", err)
+	}
+
+	observed := findUser(mysql.Users, user.GetUsername())
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	user.Status.AtProvider = mapObservation(observed)
+
+	secret, err := p.exo.RevealDBAASMysqlUserPassword(ctx, string(mysql.Name), user.GetUsername())
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("cannot reveal password for MySQL user: %w", err)
+	}
+
+	desiredPassword, err := pipelineutil.FetchPassword(ctx, p.kube, user.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	caCert, err := p.exo.GetDBAASCACertificate(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot retrieve CA certificate")
+	}
+
+	connDetails, err := connectionDetails(mysql, user.GetUsername(), secret.Password, caCert.Certificate)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot read connection details")
+	}
+
+	user.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  isUpToDate(user.Spec.ForProvider, user.Status.AtProvider, desiredPassword, secret.Password),
+		ConnectionDetails: connDetails,
+	}, nil
+}
+
+func findUser(users []exoscalesdk.DBAASServiceMysqlUsers, username string) *exoscalesdk.DBAASServiceMysqlUsers {
+	for _, u := range users {
+		if u.Username == username {
+			return &u
+		}
+	}
+	return nil
+}
+
+// mapObservation fills the status fields from the given user.
+func mapObservation(user *exoscalesdk.DBAASServiceMysqlUsers) exoscalev1.MySQLUserObservation {
+	return exoscalev1.MySQLUserObservation{
+		Username:             user.Username,
+		Type:                 user.Type,
+		AuthenticationPlugin: user.Authentication,
+	}
+}
+
+// isUpToDate returns true if the observed user matches the desired spec.
+// The authentication plugin and password are only compared if set in the spec.
+func isUpToDate(spec exoscalev1.MySQLUserParameters, observed exoscalev1.MySQLUserObservation, desiredPassword, observedPassword string) bool {
+	if spec.AuthenticationPlugin != "" && spec.AuthenticationPlugin != observed.AuthenticationPlugin {
+		return false
+	}
+	return desiredPassword == "" || desiredPassword == observedPassword
+}
+
+// connectionDetails returns the connection details of the instance with the credentials of the given user.
+func connectionDetails(in *exoscalesdk.DBAASServiceMysql, username, password, ca string) (managed.ConnectionDetails, error) {
+	uri := in.URI
+	// uri may be absent
+	if uri == "" {
+		if in.ConnectionInfo == nil || len(in.ConnectionInfo.URI) == 0 {
+			return map[string][]byte{}, nil
+		}
+		uri = in.ConnectionInfo.URI[0]
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse connection URI: %w", err)
+	}
+	parsed.User = url.UserPassword(username, password)
+	return map[string][]byte{
+		"MYSQL_USER":     []byte(username),
+		"MYSQL_PASSWORD": []byte(password),
+		"MYSQL_URL":      []byte(parsed.String()),
+		"MYSQL_DB":       []byte(strings.TrimPrefix(parsed.Path, "/")),
+		"MYSQL_HOST":     []byte(parsed.Hostname()),
+		"MYSQL_PORT":     []byte(parsed.Port()),
+		"ca.crt":         []byte(ca),
+	}, nil
+}
@@ -0,0 +1,46 @@
+package mysqlusercontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+// The authentication plugin can only be changed together with the password.
+// If no password is referenced in the spec, the current password is kept.
+func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Updating resource")
+
+	user := mg.(*exoscalev1.MySQLUser)
+	spec := user.Spec.ForProvider
+
+	password, err := pipelineutil.FetchPassword(ctx, p.kube, user.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if password == "" {
+		secret, err := p.exo.RevealDBAASMysqlUserPassword(ctx, spec.InstanceName, user.GetUsername())
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, "cannot reveal password for MySQL user")
+		}
+		password = secret.Password
+	}
+
+	resp, err := p.exo.ResetDBAASMysqlUserPassword(ctx, spec.InstanceName, user.GetUsername(), exoscalesdk.ResetDBAASMysqlUserPasswordRequest{
+		Authentication: exoscalesdk.EnumMysqlAuthenticationPlugin(spec.AuthenticationPlugin),
+		Password:       exoscalesdk.DBAASUserPassword(password),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot reset password")
+	}
+	log.V(1).Info("Response", "message", resp.Message)
+	return managed.ExternalUpdate{}, nil
+}
@@ -0,0 +1,54 @@
+// Package notification reports the service notifications of DBaaS services as events.
+package notification
+
+import (
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+// ReasonServiceNotification is the reason of the events recorded for service notifications.
+const ReasonServiceNotification event.Reason = "ServiceNotification"
+
+// RecordWarnings records a Warning event for every warning-level notification in current that isn't in previous.
+func RecordWarnings(recorder event.Recorder, mg resource.Managed, previous, current []exoscalev1.Notification) {
+	for _, n := range NewWarnings(previous, current) {
+		recorder.Event(mg, event.Event{
+			Type:    event.TypeWarning,
+			Reason:  ReasonServiceNotification,
+			Message: fmt.Sprintf("%s: %s", n.Type, n.Message),
+		})
+	}
+}
+
+// NewWarnings returns the warning-level notifications in current that aren't in previous.
+// Notifications are identified by their type and message, as the metadata may change while a notification is active.
+func NewWarnings(previous, current []exoscalev1.Notification) []exoscalev1.Notification {
+	known := make(map[notificationKey]struct{}, len(previous))
+	for _, n := range previous {
+		known[key(n)] = struct{}{}
+	}
+	var warnings []exoscalev1.Notification
+	for _, n := range current {
+		if n.Level != exoscalesdk.DBAASServiceNotificationLevelWarning {
+			continue
+		}
+		if _, ok := known[key(n)]; ok {
+			continue
+		}
+		warnings = append(warnings, n)
+	}
+	return warnings
+}
+
+type notificationKey struct {
+	notificationType exoscalesdk.DBAASServiceNotificationType
+	message          string
+}
+
+func key(n exoscalev1.Notification) notificationKey {
+	return notificationKey{notificationType: n.Type, message: n.Message}
+}
@@ -0,0 +1,59 @@
+package notification
+
+import (
+	"testing"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNewWarnings(t *testing.T) {
+	eol := exoscalev1.Notification{
+		Level:   exoscalesdk.DBAASServiceNotificationLevelWarning,
+		Type:    exoscalesdk.DBAASServiceNotificationTypeServiceEndOfLife,
+		Message: "Version 13 reaches its end of life",
+	}
+	maintenance := exoscalev1.Notification{
+		Level:   exoscalesdk.DBAASServiceNotificationLevelWarning,
+		Type:    exoscalesdk.DBAASServiceNotificationTypeServicePoweredOffRemoval,
+		Message: "Service will be removed",
+	}
+	notice := exoscalev1.Notification{
+		Level:   exoscalesdk.DBAASServiceNotificationLevelNotice,
+		Type:    exoscalesdk.DBAASServiceNotificationTypeServiceEndOfLife,
+		Message: "Version 14 reaches its end of life",
+	}
+	eolWithMetadata := eol
+	eolWithMetadata.Metadata = runtime.RawExtension{Raw: []byte(`{"end_of_life_date":"2025-11-13"}`)}
+
+	tests := map[string]struct {
+		givenPrevious []exoscalev1.Notification
+		givenCurrent  []exoscalev1.Notification
+		expected      []exoscalev1.Notification
+	}{
+		"NoNotifications": {},
+		"NewWarning": {
+			givenCurrent: []exoscalev1.Notification{eol},
+			expected:     []exoscalev1.Notification{eol},
+		},
+		"KnownWarning": {
+			givenPrevious: []exoscalev1.Notification{eol},
+			givenCurrent:  []exoscalev1.Notification{eolWithMetadata},
+		},
+		"Notice": {
+			givenCurrent: []exoscalev1.Notification{notice},
+		},
+		"AdditionalWarning": {
+			givenPrevious: []exoscalev1.Notification{eol, notice},
+			givenCurrent:  []exoscalev1.Notification{eol, notice, maintenance},
+			expected:      []exoscalev1.Notification{maintenance},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NewWarnings(tc.givenPrevious, tc.givenCurrent))
+		})
+	}
+}
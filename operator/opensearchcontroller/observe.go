@@ -5,15 +5,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	exoscalesdk "github.com/exoscale/egoscale/v3"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 
 	"github.com/go-logr/logr"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/externalendpoint"
 	"github.com/vshn/provider-exoscale/operator/mapper"
+	"github.com/vshn/provider-exoscale/operator/notification"
+	"github.com/vshn/provider-exoscale/operator/trigger"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 )
 
@@ -34,10 +39,13 @@ func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	log.V(1).Info("retrieved openSearchInstance", "state", opensearch.State)
 
+	previousTriggers := openSearchInstance.Status.AtProvider.Triggers
+	previousNotifications := openSearchInstance.Status.AtProvider.Notifications
 	openSearchInstance.Status.AtProvider, err = mapObservation(opensearch)
 	if err != nil {
 		log.Error(err, "cannot map openSearchInstance observation, ignoring")
 	}
+	notification.RecordWarnings(p.recorder, openSearchInstance, previousNotifications, openSearchInstance.Status.AtProvider.Notifications)
 	var state exoscalesdk.EnumServiceState
 	if opensearch.State != "" {
 		state = opensearch.State
@@ -54,6 +62,7 @@ func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	default:
 		log.V(2).Info("ignoring unknown openSearchInstance state", "state", state)
 	}
+	mapper.SetBackupCondition(&openSearchInstance.Status.ConditionedStatus, openSearchInstance.Status.AtProvider.LastBackupTime, opensearch.CreatedAT, openSearchInstance.Spec.ForProvider.MaxBackupAge, time.Now())
 
 	connDetails, err := connectionDetails(ctx, opensearch, p.exo)
 	if err != nil {
@@ -65,15 +74,36 @@ func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, fmt.Errorf("cannot parse parameters: %w", err)
 	}
 
-	currentParams, err := setSettingsDefaults(ctx, *p.exo, &openSearchInstance.Spec.ForProvider)
+	aclConfig, err := p.exo.GetDBAASOpensearchAclConfig(ctx, openSearchInstance.GetInstanceName())
 	if err != nil {
-		log.Error(err, "unable to set opensearch settings schema")
-		currentParams = &openSearchInstance.Spec.ForProvider
+		return managed.ExternalObservation{}, fmt.Errorf("cannot observe ACL config: %w", err)
+	}
+	params.ACLEnabled = ptr.To(ptr.Deref(aclConfig.AclEnabled, false))
+	params.ExtendedACLEnabled = ptr.To(ptr.Deref(aclConfig.ExtendedAclEnabled, false))
+	openSearchInstance.Status.AtProvider.ACLEnabled = *params.ACLEnabled
+	openSearchInstance.Status.AtProvider.ExtendedACLEnabled = *params.ExtendedACLEnabled
+
+	currentParams := &openSearchInstance.Spec.ForProvider
+	if currentParams.SettingsPolicy != exoscalev1.SettingsPolicyManagedKeysOnly {
+		// Unset settings are expected to have their default value.
+		currentParams, err = setSettingsDefaults(ctx, *p.exo, &openSearchInstance.Spec.ForProvider)
+		if err != nil {
+			log.Error(err, "unable to set opensearch settings schema")
+			currentParams = &openSearchInstance.Spec.ForProvider
+		}
+	}
+
+	endpoints, endpointsUpToDate, err := externalendpoint.Observe(ctx, p.kube, p.exo, &openSearchInstance.Status.ConditionedStatus, openSearchInstance.GetInstanceName(), openSearchInstance.Spec.ForProvider.ExternalEndpoints)
+	if err != nil {
+		return managed.ExternalObservation{}, err
 	}
+	openSearchInstance.Status.AtProvider.ExternalEndpoints = endpoints
+
+	openSearchInstance.Status.AtProvider.Triggers = previousTriggers
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  isUpToDate(currentParams, params, log),
+		ResourceUpToDate:  isUpToDate(currentParams, params, log) && endpointsUpToDate && trigger.IsUpToDate(openSearchInstance, previousTriggers),
 		ConnectionDetails: connDetails,
 	}, nil
 }
@@ -106,8 +136,10 @@ func isUpToDate(current, external *exoscalev1.OpenSearchParameters, log logr.Log
 		"Maintenance":        current.Maintenance.Equals(external.Maintenance),
 		"Zone":               current.Zone == external.Zone,
 		"Size":               current.Size.Equals(external.Size),
-		"IPFilter":           mapper.IsSameStringSet(current.IPFilter, &extIPFilter),
-		"OpenSearchSettings": mapper.CompareSettings(current.OpenSearchSettings, external.OpenSearchSettings),
+		"IPFilter":           mapper.IsSameIPFilter(current.IPFilter, &extIPFilter),
+		"OpenSearchSettings": mapper.CompareSettingsWithPolicy(current.SettingsPolicy, current.OpenSearchSettings, external.OpenSearchSettings),
+		"ACLEnabled":         isSameFlag(current.ACLEnabled, ptr.Deref(external.ACLEnabled, false)),
+		"ExtendedACLEnabled": isSameFlag(current.ExtendedACLEnabled, ptr.Deref(external.ExtendedACLEnabled, false)),
 	}
 	ok := true
 	for k, v := range checks {
@@ -119,11 +151,17 @@ func isUpToDate(current, external *exoscalev1.OpenSearchParameters, log logr.Log
 	return ok
 }
 
+// isSameFlag returns true if the flag isn't managed or if it's set to the observed value.
+func isSameFlag(desired *bool, observed bool) bool {
+	return desired == nil || *desired == observed
+}
+
 func mapObservation(instance *exoscalesdk.DBAASServiceOpensearch) (exoscalev1.OpenSearchObservation, error) {
 	observation := exoscalev1.OpenSearchObservation{
 		MajorVersion: instance.Version,
 		NodeStates:   mapper.ToNodeStates(&instance.NodeStates),
 	}
+	observation.Backups, observation.LastBackupTime = mapper.ToBackups(instance.Backups)
 
 	jsonSettings, err := json.Marshal(instance.OpensearchSettings)
 	if err != nil {
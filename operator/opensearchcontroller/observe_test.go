@@ -0,0 +1,34 @@
+package opensearchcontroller
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestIsUpToDate_ACL(t *testing.T) {
+	tests := map[string]struct {
+		desired  *bool
+		observed bool
+		expected bool
+	}{
+		"Unmanaged_Enabled":  {desired: nil, observed: true, expected: true},
+		"Unmanaged_Disabled": {desired: nil, observed: false, expected: true},
+		"Same":               {desired: ptr.To(true), observed: true, expected: true},
+		"Different":          {desired: ptr.To(false), observed: true, expected: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			current := &exoscalev1.OpenSearchParameters{}
+			current.ACLEnabled = tc.desired
+			current.ExtendedACLEnabled = tc.desired
+			external := &exoscalev1.OpenSearchParameters{}
+			external.ACLEnabled = ptr.To(tc.observed)
+			external.ExtendedACLEnabled = ptr.To(tc.observed)
+			assert.Equal(t, tc.expected, isUpToDate(current, external, logr.Discard()))
+		})
+	}
+}
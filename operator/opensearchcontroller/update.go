@@ -7,6 +7,8 @@ import (
 
 	exoscalesdk "github.com/exoscale/egoscale/v3"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/externalendpoint"
+	"github.com/vshn/provider-exoscale/operator/trigger"
 
 	controllerruntime "sigs.k8s.io/controller-runtime"
 
@@ -21,6 +23,10 @@ func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	openSearchInstance := mg.(*exoscalev1.OpenSearch)
 
+	openSearchInstance.Status.AtProvider.Triggers = trigger.Handle(ctx, p.recorder, openSearchInstance, openSearchInstance.GetInstanceName(), trigger.Actions{
+		trigger.MaintenanceAnnotation: p.exo.StartDBAASOpensearchMaintenance,
+	}, openSearchInstance.Status.AtProvider.Triggers)
+
 	forProvider := openSearchInstance.Spec.ForProvider
 	settings := exoscalesdk.JSONSchemaOpensearch{}
 	if len(forProvider.OpenSearchSettings.Raw) != 0 {
@@ -47,5 +53,38 @@ func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, err
 	}
 	log.V(1).Info("response", "message", string(resp.Message))
+
+	observed := openSearchInstance.Status.AtProvider
+	if !isSameFlag(forProvider.ACLEnabled, observed.ACLEnabled) || !isSameFlag(forProvider.ExtendedACLEnabled, observed.ExtendedACLEnabled) {
+		// The ACL config is only written if needed, as the ACL rules are written by OpenSearchUsers concurrently.
+		err = p.updateACLConfig(ctx, openSearchInstance.GetInstanceName(), forProvider)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	err = externalendpoint.Update(ctx, p.kube, p.exo, openSearchInstance.GetInstanceName(), forProvider.ExternalEndpoints, openSearchInstance.Status.AtProvider.ExternalEndpoints)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 	return managed.ExternalUpdate{}, nil
 }
+
+// updateACLConfig sets the ACL flags of the instance that are set in the spec.
+// The ACL entries are managed by OpenSearchUsers and are left untouched.
+func (p *pipeline) updateACLConfig(ctx context.Context, instanceName string, forProvider exoscalev1.OpenSearchParameters) error {
+	aclConfig, err := p.exo.GetDBAASOpensearchAclConfig(ctx, instanceName)
+	if err != nil {
+		return fmt.Errorf("cannot get ACL config: %w", err)
+	}
+	if forProvider.ACLEnabled != nil {
+		aclConfig.AclEnabled = forProvider.ACLEnabled
+	}
+	if forProvider.ExtendedACLEnabled != nil {
+		aclConfig.ExtendedAclEnabled = forProvider.ExtendedACLEnabled
+	}
+	_, err = p.exo.UpdateDBAASOpensearchAclConfig(ctx, instanceName, *aclConfig)
+	if err != nil {
+		return fmt.Errorf("cannot update ACL config: %w", err)
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/vshn/provider-exoscale/operator/pipelineutil"
 	"github.com/vshn/provider-exoscale/operator/webhook"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -34,40 +35,61 @@ func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (adm
 	}
 	v.log.V(1).Info("validate create")
 
-	availableVersions, err := v.getAvailableVersions(ctx, obj)
+	dbaasType, err := v.getServiceType(ctx, obj)
 	if err != nil {
 		return nil, err
 	}
 
-	err = v.validateVersion(ctx, obj, availableVersions)
+	err = v.validateVersion(ctx, obj, dbaasType.AvailableVersions)
 	if err != nil {
 		return nil, err
 	}
 
-	return nil, v.validateSpec(openSearchInstance)
+	err = webhook.ValidatePlan(openSearchInstance.Spec.ForProvider.Size.Plan, openSearchInstance.Spec.ForProvider.Zone.String(), dbaasType.Plans)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateIpFilter(openSearchInstance.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	err = v.validateSpec(openSearchInstance)
+	if err != nil {
+		return nil, err
+	}
+	return nil, v.validateSettingsSchema(ctx, nil, openSearchInstance)
 }
 
-func (v *Validator) getAvailableVersions(ctx context.Context, obj runtime.Object) ([]string, error) {
+func (v *Validator) getServiceType(ctx context.Context, obj runtime.Object) (*exoscalesdk.DBAASServiceType, error) {
 	openSearchInstance := obj.(*exoscalev1.OpenSearch)
 
-	v.log.V(1).Info("get opensearch available versions")
+	v.log.V(1).Info("get opensearch service type")
 	exo, err := pipelineutil.OpenExoscaleClient(ctx, v.kube, openSearchInstance.GetProviderConfigReference().Name, exoscalesdk.ClientOptWithEndpoint(common.ZoneTranslation[openSearchInstance.Spec.ForProvider.Zone]))
 	if err != nil {
 		return nil, fmt.Errorf("open exoscale client failed: %w", err)
 	}
 
-	// get opensearch available versions
 	resp, err := exo.Exoscale.GetDBAASServiceType(ctx, serviceType)
 	if err != nil {
 		return nil, fmt.Errorf("get DBaaS service type failed: %w", err)
 	}
 
 	v.log.V(1).Info("DBaaS service type", "name", string(resp.Name), "description", string(resp.Description))
+	return resp, nil
+}
 
-	if resp.AvailableVersions == nil {
-		return nil, fmt.Errorf("opensearch available versions not found")
+// validatePlanChange validates a changed plan and warns about downgrades.
+func (v *Validator) validatePlanChange(ctx context.Context, oldInst, newInst exoscalev1.OpenSearch) (admission.Warnings, error) {
+	oldPlan, newPlan := oldInst.Spec.ForProvider.Size.Plan, newInst.Spec.ForProvider.Size.Plan
+	if oldPlan == newPlan {
+		return nil, nil
 	}
-	return resp.AvailableVersions, nil
+	dbaasType, err := v.getServiceType(ctx, &newInst)
+	if err != nil {
+		return nil, err
+	}
+	return webhook.ValidatePlanChange(oldPlan, newPlan, newInst.Spec.ForProvider.Zone.String(), dbaasType.Plans)
 }
 
 func (v *Validator) validateVersion(_ context.Context, obj runtime.Object, availableVersions []string) error {
@@ -78,7 +100,7 @@ func (v *Validator) validateVersion(_ context.Context, obj runtime.Object, avail
 }
 
 // ValidateUpdate implements admission.CustomValidator.
-func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	newInstance, ok := newObj.(*exoscalev1.OpenSearch)
 	if !ok {
 		return nil, fmt.Errorf("invalid managed resource type %T for opensearch webhook", newObj)
@@ -89,22 +111,66 @@ func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Obj
 	}
 	v.log.V(1).Info("validate update")
 
-	err := v.validateSpec(newInstance)
+	err := webhook.ValidateIPFilterUpdate(oldInstance.Spec.ForProvider.IPFilter, newInstance.Spec.ForProvider.IPFilter)
+	if err != nil {
+		return nil, err
+	}
+	err = v.validateSpec(newInstance)
+	if err != nil {
+		return nil, err
+	}
+	err = v.compare(oldInstance, newInstance)
 	if err != nil {
 		return nil, err
 	}
-	return nil, v.compare(oldInstance, newInstance)
+	err = v.validateSettingsSchema(ctx, oldInstance, newInstance)
+	if err != nil {
+		return nil, err
+	}
+	return v.validatePlanChange(ctx, *oldInstance, *newInstance)
 }
 
 // ValidateDelete implements admission.CustomValidator.
-func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
-	v.log.V(1).Info("validate delete (noop)")
-	return nil, nil
+func (v *Validator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	instance, ok := obj.(*exoscalev1.OpenSearch)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for opensearch webhook", obj)
+	}
+	v.log.V(1).Info("validate delete")
+	return nil, webhook.ValidateTerminationProtection(instance, instance.Spec.ForProvider.TerminationProtection, instance.Status.AtProvider.TerminationProtection)
+}
+
+// validateSettingsSchema validates new or changed settings against the settings schema of the service.
+// oldInst is nil on creation.
+func (v *Validator) validateSettingsSchema(ctx context.Context, oldInst, newInst *exoscalev1.OpenSearch) error {
+	var previous []webhook.Setting
+	if oldInst != nil {
+		previous = settingsOf(oldInst.Spec.ForProvider)
+	}
+	changed := webhook.ChangedSettings(previous, settingsOf(newInst.Spec.ForProvider))
+	if len(changed) == 0 {
+		return nil
+	}
+	exo, err := pipelineutil.OpenExoscaleClient(ctx, v.kube, newInst.GetProviderConfigReference().Name, exoscalesdk.ClientOptWithEndpoint(common.ZoneTranslation[newInst.Spec.ForProvider.Zone]))
+	if err != nil {
+		return fmt.Errorf("open exoscale client failed: %w", err)
+	}
+	schemas, err := fetchSettingSchema(ctx, exo.Exoscale)
+	if err != nil {
+		return fmt.Errorf("cannot fetch settings schema: %w", err)
+	}
+	return webhook.ValidateSettings(schemas, changed)
+}
+
+func settingsOf(obj exoscalev1.OpenSearchParameters) []webhook.Setting {
+	path := field.NewPath("spec", "forProvider")
+	return []webhook.Setting{
+		{Schema: "opensearch", Path: path.Child("openSearchSettings"), Value: obj.OpenSearchSettings},
+	}
 }
 
 func (v *Validator) validateSpec(obj *exoscalev1.OpenSearch) error {
 	for _, validatorFn := range []func(exoscalev1.OpenSearchParameters) error{
-		validateIpFilter,
 		validateMaintenanceSchedule,
 		validateSettings,
 	} {
@@ -116,10 +182,7 @@ func (v *Validator) validateSpec(obj *exoscalev1.OpenSearch) error {
 }
 
 func validateIpFilter(obj exoscalev1.OpenSearchParameters) error {
-	if len(obj.IPFilter) == 0 {
-		return fmt.Errorf("IP filter cannot be empty")
-	}
-	return nil
+	return webhook.ValidateIPFilter(obj.IPFilter)
 }
 
 func validateMaintenanceSchedule(obj exoscalev1.OpenSearchParameters) error {
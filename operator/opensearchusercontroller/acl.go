@@ -0,0 +1,65 @@
+package opensearchusercontroller
+
+import (
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+// findRules returns the ACL rules of the given user.
+func findRules(acls []exoscalesdk.DBAASOpensearchAclConfigAcls, username string) []exoscalev1.OpenSearchACLRule {
+	for _, acl := range acls {
+		if string(acl.Username) != username {
+			continue
+		}
+		rules := make([]exoscalev1.OpenSearchACLRule, 0, len(acl.Rules))
+		for _, r := range acl.Rules {
+			rules = append(rules, exoscalev1.OpenSearchACLRule{Index: r.Index, Permission: string(r.Permission)})
+		}
+		return rules
+	}
+	return nil
+}
+
+// setRules returns the ACLs with the rules of the given user replaced.
+// The ACL entry of the user is removed if there are no rules.
+func setRules(acls []exoscalesdk.DBAASOpensearchAclConfigAcls, username string, rules []exoscalev1.OpenSearchACLRule) []exoscalesdk.DBAASOpensearchAclConfigAcls {
+	res := make([]exoscalesdk.DBAASOpensearchAclConfigAcls, 0, len(acls)+1)
+	for _, acl := range acls {
+		if string(acl.Username) != username {
+			res = append(res, acl)
+		}
+	}
+	if len(rules) == 0 {
+		return res
+	}
+	entry := exoscalesdk.DBAASOpensearchAclConfigAcls{
+		Username: exoscalesdk.DBAASUserUsername(username),
+		Rules:    make([]exoscalesdk.DBAASOpensearchAclConfigAclsRules, 0, len(rules)),
+	}
+	for _, r := range rules {
+		entry.Rules = append(entry.Rules, exoscalesdk.DBAASOpensearchAclConfigAclsRules{
+			Index:      r.Index,
+			Permission: exoscalesdk.EnumOpensearchRulePermission(r.Permission),
+		})
+	}
+	return append(res, entry)
+}
+
+// isSameRuleSet returns true if both lists contain the same rules, regardless of their order.
+func isSameRuleSet(a, b []exoscalev1.OpenSearchACLRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[exoscalev1.OpenSearchACLRule]int, len(a))
+	for _, r := range a {
+		set[r]++
+	}
+	for _, r := range b {
+		if set[r] == 0 {
+			return false
+		}
+		set[r]--
+	}
+	return true
+}
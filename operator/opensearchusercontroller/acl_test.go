@@ -0,0 +1,61 @@
+package opensearchusercontroller
+
+import (
+	"testing"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+func TestSetRules(t *testing.T) {
+	acls := []exoscalesdk.DBAASOpensearchAclConfigAcls{
+		{Username: "other", Rules: []exoscalesdk.DBAASOpensearchAclConfigAclsRules{{Index: "*", Permission: "read"}}},
+		{Username: "shipper", Rules: []exoscalesdk.DBAASOpensearchAclConfigAclsRules{{Index: "old-*", Permission: "admin"}}},
+	}
+	rules := []exoscalev1.OpenSearchACLRule{
+		{Index: "logs-*", Permission: "write"},
+		{Index: "metrics-*", Permission: "readwrite"},
+	}
+
+	t.Run("Replace", func(t *testing.T) {
+		res := setRules(acls, "shipper", rules)
+		assert.Len(t, res, 2)
+		assert.Equal(t, acls[0], res[0])
+		assert.Equal(t, rules, findRules(res, "shipper"))
+	})
+
+	t.Run("Add", func(t *testing.T) {
+		res := setRules(acls, "dashboard", rules)
+		assert.Len(t, res, 3)
+		assert.Equal(t, rules, findRules(res, "dashboard"))
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		res := setRules(acls, "shipper", nil)
+		assert.Len(t, res, 1)
+		assert.Nil(t, findRules(res, "shipper"))
+	})
+}
+
+func TestIsSameRuleSet(t *testing.T) {
+	read := exoscalev1.OpenSearchACLRule{Index: "logs-*", Permission: "read"}
+	write := exoscalev1.OpenSearchACLRule{Index: "logs-*", Permission: "write"}
+
+	tests := map[string]struct {
+		a, b     []exoscalev1.OpenSearchACLRule
+		expected bool
+	}{
+		"Empty":          {expected: true},
+		"NilAndEmpty":    {a: []exoscalev1.OpenSearchACLRule{}, expected: true},
+		"Same":           {a: []exoscalev1.OpenSearchACLRule{read, write}, b: []exoscalev1.OpenSearchACLRule{read, write}, expected: true},
+		"DifferentOrder": {a: []exoscalev1.OpenSearchACLRule{read, write}, b: []exoscalev1.OpenSearchACLRule{write, read}, expected: true},
+		"Different":      {a: []exoscalev1.OpenSearchACLRule{read}, b: []exoscalev1.OpenSearchACLRule{write}, expected: false},
+		"Missing":        {a: []exoscalev1.OpenSearchACLRule{read, write}, b: []exoscalev1.OpenSearchACLRule{read}, expected: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isSameRuleSet(tc.a, tc.b))
+		})
+	}
+}
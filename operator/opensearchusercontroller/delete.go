@@ -0,0 +1,40 @@
+package opensearchusercontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Delete implements managed.ExternalClient.
+// The ACL rules of the user are removed before the user is deleted.
+func (p *pipeline) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Deleting resource")
+
+	user := mg.(*exoscalev1.OpenSearchUser)
+	instanceName := user.Spec.ForProvider.InstanceName
+
+	if len(user.Status.AtProvider.ACLRules) > 0 {
+		err := p.updateRules(ctx, instanceName, user.GetUsername(), nil)
+		if err != nil && !errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalDelete{}, err
+		}
+	}
+
+	resp, err := p.exo.DeleteDBAASOpensearchUser(ctx, instanceName, user.GetUsername())
+	if err != nil {
+		if errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, fmt.Errorf("cannot delete user: %w", err)
+	}
+	log.V(1).Info("Response when deleting", "message", resp.Message)
+	return managed.ExternalDelete{}, nil
+}
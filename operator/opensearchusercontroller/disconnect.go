@@ -0,0 +1,7 @@
+package opensearchusercontroller
+
+import "context"
+
+func (p *pipeline) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,112 @@
+package opensearchusercontroller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/pipelineutil"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	user := mg.(*exoscalev1.OpenSearchUser)
+
+	opensearch, err := p.exo.GetDBAASServiceOpensearch(ctx, user.Spec.ForProvider.InstanceName)
+	if err != nil {
+		if errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, fmt.Errorf("cannot observe OpenSearch instance: %w", err)
+	}
+
+	observed := findUser(opensearch.Users, user.GetUsername())
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	aclConfig, err := p.exo.GetDBAASOpensearchAclConfig(ctx, string(opensearch.Name))
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("cannot observe ACL config: %w", err)
+	}
+	user.Status.AtProvider = exoscalev1.OpenSearchUserObservation{
+		Username: observed.Username,
+		Type:     observed.Type,
+		ACLRules: findRules(aclConfig.Acls, user.GetUsername()),
+	}
+
+	secret, err := p.exo.RevealDBAASOpensearchUserPassword(ctx, string(opensearch.Name), user.GetUsername())
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("cannot reveal password for OpenSearch user: %w", err)
+	}
+
+	desiredPassword, err := pipelineutil.FetchPassword(ctx, p.kube, user.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	user.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  isUpToDate(user.Spec.ForProvider, user.Status.AtProvider, desiredPassword, secret.Password),
+		ConnectionDetails: connectionDetails(opensearch, user.GetUsername(), secret.Password),
+	}, nil
+}
+
+func findUser(users []exoscalesdk.DBAASServiceOpensearchUsers, username string) *exoscalesdk.DBAASServiceOpensearchUsers {
+	for _, u := range users {
+		if u.Username == username {
+			return &u
+		}
+	}
+	return nil
+}
+
+// isUpToDate returns true if the observed user matches the desired spec.
+// The password is only compared if a password is referenced in the spec.
+func isUpToDate(spec exoscalev1.OpenSearchUserParameters, observed exoscalev1.OpenSearchUserObservation, desiredPassword, observedPassword string) bool {
+	if !isSameRuleSet(spec.ACLRules, observed.ACLRules) {
+		return false
+	}
+	return desiredPassword == "" || desiredPassword == observedPassword
+}
+
+// connectionDetails returns the connection details of the instance with the credentials of the given user.
+func connectionDetails(in *exoscalesdk.DBAASServiceOpensearch, username, password string) managed.ConnectionDetails {
+	details := map[string][]byte{
+		"OPENSEARCH_USER":     []byte(username),
+		"OPENSEARCH_PASSWORD": []byte(password),
+		"OPENSEARCH_URI":      []byte(withCredentials(in.URI, username, password)),
+	}
+	if in.URIParams != nil {
+		host, _ := in.URIParams["host"].(string)
+		port, _ := in.URIParams["port"].(string)
+		details["OPENSEARCH_HOST"] = []byte(host)
+		details["OPENSEARCH_PORT"] = []byte(port)
+	}
+	if in.ConnectionInfo != nil {
+		details["OPENSEARCH_DASHBOARD_URI"] = []byte(withCredentials(in.ConnectionInfo.DashboardURI, username, password))
+	}
+	return details
+}
+
+// withCredentials replaces the credentials of the given URI if it contains any.
+func withCredentials(uri, username, password string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.User == nil {
+		return uri
+	}
+	parsed.User = url.UserPassword(username, password)
+	return parsed.String()
+}
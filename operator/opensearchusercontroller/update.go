@@ -0,0 +1,57 @@
+package opensearchusercontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+// It updates the ACL rules and resets the password to the one referenced in the spec.
+func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Updating resource")
+
+	user := mg.(*exoscalev1.OpenSearchUser)
+	spec := user.Spec.ForProvider
+
+	if !isSameRuleSet(spec.ACLRules, user.Status.AtProvider.ACLRules) {
+		err := p.updateRules(ctx, spec.InstanceName, user.GetUsername(), spec.ACLRules)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	password, err := pipelineutil.FetchPassword(ctx, p.kube, user.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if password != "" {
+		resp, err := p.exo.ResetDBAASOpensearchUserPassword(ctx, spec.InstanceName, user.GetUsername(), exoscalesdk.ResetDBAASOpensearchUserPasswordRequest{
+			Password: exoscalesdk.DBAASUserPassword(password),
+		})
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, "cannot reset password")
+		}
+		log.V(1).Info("Response", "message", resp.Message)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// updateRules replaces the ACL rules of the given user in the ACL config of the instance.
+// The ACL config is shared by all users of the instance, hence it's read and written back as a whole.
+func (p *pipeline) updateRules(ctx context.Context, instanceName, username string, rules []exoscalev1.OpenSearchACLRule) error {
+	aclConfig, err := p.exo.GetDBAASOpensearchAclConfig(ctx, instanceName)
+	if err != nil {
+		return errors.Wrap(err, "cannot get ACL config")
+	}
+	aclConfig.Acls = setRules(aclConfig.Acls, username, rules)
+	_, err = p.exo.UpdateDBAASOpensearchAclConfig(ctx, instanceName, *aclConfig)
+	return errors.Wrap(err, "cannot update ACL config")
+}
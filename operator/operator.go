@@ -3,11 +3,22 @@ package operator
 import (
 	"github.com/vshn/provider-exoscale/operator/bucketcontroller"
 	"github.com/vshn/provider-exoscale/operator/configcontroller"
+	"github.com/vshn/provider-exoscale/operator/dbaasexternalendpointcontroller"
+	"github.com/vshn/provider-exoscale/operator/dbaasintegrationcontroller"
+	"github.com/vshn/provider-exoscale/operator/grafanacontroller"
 	"github.com/vshn/provider-exoscale/operator/iamkeycontroller"
+	"github.com/vshn/provider-exoscale/operator/kafkaaclcontroller"
 	"github.com/vshn/provider-exoscale/operator/kafkacontroller"
+	"github.com/vshn/provider-exoscale/operator/kafkausercontroller"
 	"github.com/vshn/provider-exoscale/operator/mysqlcontroller"
+	"github.com/vshn/provider-exoscale/operator/mysqldatabasecontroller"
+	"github.com/vshn/provider-exoscale/operator/mysqlusercontroller"
 	"github.com/vshn/provider-exoscale/operator/opensearchcontroller"
+	"github.com/vshn/provider-exoscale/operator/opensearchusercontroller"
+	"github.com/vshn/provider-exoscale/operator/postgresqlconnectionpoolcontroller"
 	"github.com/vshn/provider-exoscale/operator/postgresqlcontroller"
+	"github.com/vshn/provider-exoscale/operator/postgresqldatabasecontroller"
+	"github.com/vshn/provider-exoscale/operator/postgresqlusercontroller"
 	"github.com/vshn/provider-exoscale/operator/rediscontroller"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -20,10 +31,21 @@ func SetupControllers(mgr ctrl.Manager) error {
 		configcontroller.SetupController,
 		iamkeycontroller.SetupController,
 		mysqlcontroller.SetupController,
+		mysqlusercontroller.SetupController,
+		mysqldatabasecontroller.SetupController,
 		postgresqlcontroller.SetupController,
+		postgresqlusercontroller.SetupController,
+		postgresqldatabasecontroller.SetupController,
+		postgresqlconnectionpoolcontroller.SetupController,
 		rediscontroller.SetupController,
+		grafanacontroller.SetupController,
 		kafkacontroller.SetupController,
+		kafkausercontroller.SetupController,
+		kafkaaclcontroller.SetupController,
 		opensearchcontroller.SetupController,
+		opensearchusercontroller.SetupController,
+		dbaasintegrationcontroller.SetupController,
+		dbaasexternalendpointcontroller.SetupController,
 	} {
 		if err := setup(mgr); err != nil {
 			return err
@@ -38,10 +60,21 @@ func SetupWebhooks(mgr ctrl.Manager) error {
 		bucketcontroller.SetupWebhook,
 		iamkeycontroller.SetupWebhook,
 		mysqlcontroller.SetupWebhook,
+		mysqlusercontroller.SetupWebhook,
+		mysqldatabasecontroller.SetupWebhook,
 		postgresqlcontroller.SetupWebhook,
+		postgresqlusercontroller.SetupWebhook,
+		postgresqldatabasecontroller.SetupWebhook,
+		postgresqlconnectionpoolcontroller.SetupWebhook,
 		rediscontroller.SetupWebhook,
+		grafanacontroller.SetupWebhook,
 		kafkacontroller.SetupWebhook,
+		kafkausercontroller.SetupWebhook,
+		kafkaaclcontroller.SetupWebhook,
 		opensearchcontroller.SetupWebhook,
+		opensearchusercontroller.SetupWebhook,
+		dbaasintegrationcontroller.SetupWebhook,
+		dbaasexternalendpointcontroller.SetupWebhook,
 	} {
 		if err := setup(mgr); err != nil {
 			return err
@@ -0,0 +1,29 @@
+package pipelineutil
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FetchPassword returns the password in the Secret key given by the reference.
+// Returns an empty string if no reference is given.
+func FetchPassword(ctx context.Context, kube client.Client, ref *xpv1.SecretKeySelector) (string, error) {
+	if ref == nil {
+		return "", nil
+	}
+	secret := &corev1.Secret{}
+	err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret)
+	if err != nil {
+		return "", fmt.Errorf("cannot get password secret: %w", err)
+	}
+	password, ok := secret.Data[ref.Key]
+	if !ok || len(password) == 0 {
+		return "", fmt.Errorf("key %q not found in secret %s/%s", ref.Key, ref.Namespace, ref.Name)
+	}
+	return string(password), nil
+}
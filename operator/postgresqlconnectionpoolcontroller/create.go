@@ -0,0 +1,40 @@
+package postgresqlconnectionpoolcontroller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Create implements managed.ExternalClient.
+func (p *pipeline) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Creating resource")
+
+	pool := mg.(*exoscalev1.PostgreSQLConnectionPool)
+	spec := pool.Spec.ForProvider
+
+	resp, err := p.exo.CreateDBAASPGConnectionPool(ctx, spec.InstanceName, exoscalesdk.CreateDBAASPGConnectionPoolRequest{
+		Name:         exoscalesdk.DBAASPGPoolName(pool.GetPoolName()),
+		DatabaseName: exoscalesdk.DBAASDatabaseName(spec.DatabaseName),
+		Username:     exoscalesdk.DBAASPGPoolUsername(spec.Username),
+		Mode:         exoscalesdk.EnumPGPoolMode(spec.Mode),
+		Size:         exoscalesdk.DBAASPGPoolSize(spec.Size),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			// According to the ExternalClient Interface, create needs to be idempotent.
+			// However the exoscale client doesn't return very helpful errors, so we need to make this brittle matching to find if we get an already exits error
+			return managed.ExternalCreation{}, nil
+		}
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create connection pool")
+	}
+	log.V(1).Info("Response", "message", resp.Message)
+	return managed.ExternalCreation{}, nil
+}
@@ -0,0 +1,121 @@
+package postgresqlconnectionpoolcontroller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	pool := mg.(*exoscalev1.PostgreSQLConnectionPool)
+
+	pg, err := p.exo.GetDBAASServicePG(ctx, pool.Spec.ForProvider.InstanceName)
+	if err != nil {
+		if errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, fmt.Errorf("cannot observe PostgreSQL instance: %w", err)
+	}
+
+	observed := findPool(pg.ConnectionPools, pool.GetPoolName())
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	pool.Status.AtProvider = mapObservation(observed)
+
+	password := ""
+	if observed.Username != "" {
+		secret, err := p.exo.RevealDBAASPostgresUserPassword(ctx, string(pg.Name), string(observed.Username))
+		if err != nil {
+			return managed.ExternalObservation{}, fmt.Errorf("cannot reveal password for PostgreSQL user: %w", err)
+		}
+		password = secret.Password
+	}
+
+	caCert, err := p.exo.GetDBAASCACertificate(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot retrieve CA certificate")
+	}
+
+	connDetails, err := connectionDetails(observed, password, caCert.Certificate)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot read connection details")
+	}
+
+	pool.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  isUpToDate(pool.Spec.ForProvider, pool.Status.AtProvider),
+		ConnectionDetails: connDetails,
+	}, nil
+}
+
+func findPool(pools []exoscalesdk.DBAASServicePGConnectionPools, name string) *exoscalesdk.DBAASServicePGConnectionPools {
+	for _, p := range pools {
+		if string(p.Name) == name {
+			return &p
+		}
+	}
+	return nil
+}
+
+// mapObservation fills the status fields from the given connection pool.
+func mapObservation(pool *exoscalesdk.DBAASServicePGConnectionPools) exoscalev1.PostgreSQLConnectionPoolObservation {
+	return exoscalev1.PostgreSQLConnectionPoolObservation{
+		Name:         string(pool.Name),
+		DatabaseName: string(pool.Database),
+		Username:     string(pool.Username),
+		Mode:         string(pool.Mode),
+		Size:         int64(pool.Size),
+	}
+}
+
+// isUpToDate returns true if the observed connection pool matches the desired spec.
+// The user is only compared if one is set in the spec.
+func isUpToDate(spec exoscalev1.PostgreSQLConnectionPoolParameters, observed exoscalev1.PostgreSQLConnectionPoolObservation) bool {
+	if spec.Username != "" && spec.Username != observed.Username {
+		return false
+	}
+	return spec.DatabaseName == observed.DatabaseName &&
+		spec.Mode == observed.Mode &&
+		spec.Size == observed.Size
+}
+
+// connectionDetails returns the connection details of the pooler.
+// The password is only added if the pool connects with a fixed user.
+func connectionDetails(pool *exoscalesdk.DBAASServicePGConnectionPools, password, ca string) (managed.ConnectionDetails, error) {
+	if pool.ConnectionURI == "" {
+		return map[string][]byte{}, nil
+	}
+	parsed, err := url.Parse(pool.ConnectionURI)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse connection URL: %w", err)
+	}
+	details := map[string][]byte{
+		"POSTGRESQL_DB":   []byte(strings.TrimPrefix(parsed.Path, "/")),
+		"POSTGRESQL_HOST": []byte(parsed.Hostname()),
+		"POSTGRESQL_PORT": []byte(parsed.Port()),
+		"ca.crt":          []byte(ca),
+	}
+	if pool.Username != "" {
+		parsed.User = url.UserPassword(string(pool.Username), password)
+		details["POSTGRESQL_USER"] = []byte(pool.Username)
+		details["POSTGRESQL_PASSWORD"] = []byte(password)
+	}
+	details["POSTGRESQL_URL"] = []byte(parsed.String())
+	return details, nil
+}
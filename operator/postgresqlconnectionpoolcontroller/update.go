@@ -0,0 +1,33 @@
+package postgresqlconnectionpoolcontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.Info("Updating resource")
+
+	pool := mg.(*exoscalev1.PostgreSQLConnectionPool)
+	spec := pool.Spec.ForProvider
+
+	resp, err := p.exo.UpdateDBAASPGConnectionPool(ctx, spec.InstanceName, pool.GetPoolName(), exoscalesdk.UpdateDBAASPGConnectionPoolRequest{
+		DatabaseName: exoscalesdk.DBAASDatabaseName(spec.DatabaseName),
+		Username:     exoscalesdk.DBAASPGPoolUsername(spec.Username),
+		Mode:         exoscalesdk.EnumPGPoolMode(spec.Mode),
+		Size:         exoscalesdk.DBAASPGPoolSize(spec.Size),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update connection pool")
+	}
+	log.V(1).Info("Response", "message", resp.Message)
+	return managed.ExternalUpdate{}, nil
+}
@@ -0,0 +1,72 @@
+package postgresqlconnectionpoolcontroller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Validator validates admission requests.
+type Validator struct {
+	log logr.Logger
+}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	pool, ok := obj.(*exoscalev1.PostgreSQLConnectionPool)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for postgresql connection pool webhook", obj)
+	}
+	v.log.V(1).Info("Validate create", "name", pool.Name)
+
+	return nil, validateSpec(pool.Spec.ForProvider)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	newPool, ok := newObj.(*exoscalev1.PostgreSQLConnectionPool)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for postgresql connection pool webhook", newObj)
+	}
+	oldPool, ok := oldObj.(*exoscalev1.PostgreSQLConnectionPool)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for postgresql connection pool webhook", oldObj)
+	}
+	v.log.V(1).Info("Validate update", "name", newPool.Name)
+
+	err := validateSpec(newPool.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	return nil, validateImmutable(oldPool.Spec.ForProvider, newPool.Spec.ForProvider)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate delete (noop)")
+	return nil, nil
+}
+
+func validateSpec(params exoscalev1.PostgreSQLConnectionPoolParameters) error {
+	if params.InstanceName == "" && params.InstanceRef == nil && params.InstanceSelector == nil {
+		return fmt.Errorf("one of instanceName, instanceRef or instanceSelector is required")
+	}
+	if params.DatabaseName == "" && params.DatabaseRef == nil && params.DatabaseSelector == nil {
+		return fmt.Errorf("one of databaseName, databaseRef or databaseSelector is required")
+	}
+	return nil
+}
+
+func validateImmutable(oldParams, newParams exoscalev1.PostgreSQLConnectionPoolParameters) error {
+	if oldParams.Zone != newParams.Zone {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.Zone, newParams.Zone)
+	}
+	if oldParams.InstanceName != "" && oldParams.InstanceName != newParams.InstanceName {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.InstanceName, newParams.InstanceName)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	exoscalesdk "github.com/exoscale/egoscale/v3"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
 	"github.com/vshn/provider-exoscale/operator/mapper"
+	"github.com/vshn/provider-exoscale/operator/migration"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -29,7 +30,16 @@ func (p *pipeline) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "cannot map spec to API request")
 	}
-	resp, err := p.exo.CreateDBAASServicePG(ctx, pgInstance.Name, body)
+	body.RecoveryBackupTime, err = p.getRecoveryBackupTime(ctx, spec)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot determine backup to recover from")
+	}
+	migrationReq, migrationVersion, err := migration.ToRequest(ctx, p.kube, spec.Migration)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot map migration to API request")
+	}
+	body.Migration = migrationReq
+	resp, err := p.exo.WithRequestInterceptor(rawSettings(spec)).CreateDBAASServicePG(ctx, pgInstance.Name, body)
 	if err != nil {
 		if strings.Contains(err.Error(), "Service name is already taken") {
 			// According to the ExternalClient Interface, create needs to be idempotent.
@@ -39,9 +49,38 @@ func (p *pipeline) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create instance")
 	}
 	log.V(1).Info("Response", "message", resp.Message)
+	err = migration.Record(pgInstance, spec.Migration, migrationVersion)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	return managed.ExternalCreation{}, nil
 }
 
+// toReadReplicaIntegrations returns the integration that creates the instance as read replica of the given service.
+// Read replicas can only be set up when the instance is created.
+func toReadReplicaIntegrations(source string) []exoscalesdk.CreateDBAASServicePGRequestIntegrations {
+	if source == "" {
+		return nil
+	}
+	return []exoscalesdk.CreateDBAASServicePGRequestIntegrations{{
+		Type:          exoscalesdk.CreateDBAASServicePGRequestIntegrationsTypeReadReplica,
+		SourceService: exoscalesdk.DBAASServiceName(source),
+	}}
+}
+
+// getRecoveryBackupTime returns the time of the backup to recover from when forking another instance.
+// The backups of the forked instance are only looked up if a backup name is given.
+func (p *pipeline) getRecoveryBackupTime(ctx context.Context, spec exoscalev1.PostgreSQLParameters) (string, error) {
+	if spec.RecoveryBackupName == "" {
+		return mapper.ToRecoveryBackupTime(nil, "", spec.RecoveryTargetTime)
+	}
+	source, err := p.exo.GetDBAASServicePG(ctx, spec.ForkFrom)
+	if err != nil {
+		return "", fmt.Errorf("cannot get forked instance %q: %w", spec.ForkFrom, err)
+	}
+	return mapper.ToRecoveryBackupTime(source.Backups, spec.RecoveryBackupName, spec.RecoveryTargetTime)
+}
+
 // fromSpecToCreateBody places the given spec into the request body.
 func fromSpecToCreateBody(spec exoscalev1.PostgreSQLParameters) (exoscalesdk.CreateDBAASServicePGRequest, error) {
 	/**
@@ -59,6 +98,18 @@ func fromSpecToCreateBody(spec exoscalev1.PostgreSQLParameters) (exoscalesdk.Cre
 			return exoscalesdk.CreateDBAASServicePGRequest{}, fmt.Errorf("invalid pgsettings: %w", err)
 		}
 	}
+	pgbouncerSettings, err := toSettings[exoscalesdk.JSONSchemaPgbouncer](spec.PgBouncerSettings)
+	if err != nil {
+		return exoscalesdk.CreateDBAASServicePGRequest{}, fmt.Errorf("invalid pgbouncer settings: %w", err)
+	}
+	pglookoutSettings, err := toSettings[exoscalesdk.JSONSchemaPglookout](spec.PGLookoutSettings)
+	if err != nil {
+		return exoscalesdk.CreateDBAASServicePGRequest{}, fmt.Errorf("invalid pglookout settings: %w", err)
+	}
+	timescaledbSettings, err := toSettings[exoscalesdk.JSONSchemaTimescaledb](spec.TimescaleDBSettings)
+	if err != nil {
+		return exoscalesdk.CreateDBAASServicePGRequest{}, fmt.Errorf("invalid timescaledb settings: %w", err)
+	}
 
 	return exoscalesdk.CreateDBAASServicePGRequest{
 		Plan: spec.Size.Plan,
@@ -73,7 +124,15 @@ func fromSpecToCreateBody(spec exoscalev1.PostgreSQLParameters) (exoscalesdk.Cre
 			Dow:  exoscalesdk.CreateDBAASServicePGRequestMaintenanceDow(spec.Maintenance.DayOfWeek),
 			Time: spec.Maintenance.TimeOfDay.String(),
 		},
-		IPFilter:   spec.IPFilter,
-		PGSettings: settings,
+		IPFilter:                spec.IPFilter,
+		PGSettings:              settings,
+		PgbouncerSettings:       pgbouncerSettings,
+		PglookoutSettings:       pglookoutSettings,
+		TimescaledbSettings:     timescaledbSettings,
+		SharedBuffersPercentage: spec.SharedBuffersPercentage,
+		WorkMem:                 spec.WorkMem,
+		SynchronousReplication:  exoscalesdk.EnumPGSynchronousReplication(spec.SynchronousReplication),
+		ForkFromService:         exoscalesdk.DBAASServiceName(spec.ForkFrom),
+		Integrations:            toReadReplicaIntegrations(spec.ReadReplicaOf),
 	}, nil
 }
@@ -0,0 +1,27 @@
+package postgresqlcontroller
+
+import (
+	"testing"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+func TestFromSpecToCreateBody_ReadReplica(t *testing.T) {
+	spec := exoscalev1.PostgreSQLParameters{ReadReplicaOf: "primary"}
+	spec.Backup.TimeOfDay = "12:00:00"
+
+	body, err := fromSpecToCreateBody(spec)
+	require.NoError(t, err)
+	assert.Equal(t, []exoscalesdk.CreateDBAASServicePGRequestIntegrations{{
+		Type:          exoscalesdk.CreateDBAASServicePGRequestIntegrationsTypeReadReplica,
+		SourceService: "primary",
+	}}, body.Integrations)
+
+	spec.ReadReplicaOf = ""
+	body, err = fromSpecToCreateBody(spec)
+	require.NoError(t, err)
+	assert.Nil(t, body.Integrations)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
@@ -17,7 +18,11 @@ import (
 
 	"github.com/go-logr/logr"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/externalendpoint"
 	"github.com/vshn/provider-exoscale/operator/mapper"
+	"github.com/vshn/provider-exoscale/operator/migration"
+	"github.com/vshn/provider-exoscale/operator/notification"
+	"github.com/vshn/provider-exoscale/operator/trigger"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 )
 
@@ -38,12 +43,25 @@ func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	log.V(1).Info("Retrieved instance", "state", pg.State)
 
+	previousUpgrade := pgInstance.Status.AtProvider.Upgrade
+	previousMigration, err := migration.Recorded(pgInstance, pgInstance.Status.AtProvider.Migration)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	previousTriggers := pgInstance.Status.AtProvider.Triggers
+	previousNotifications := pgInstance.Status.AtProvider.Notifications
 	pgInstance.Status.AtProvider, err = mapObservation(pg)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, "cannot parse instance status")
 	}
+	notification.RecordWarnings(p.recorder, pgInstance, previousNotifications, pgInstance.Status.AtProvider.Notifications)
 
 	setConditionFromState(*pg, pgInstance)
+	mapper.SetBackupCondition(&pgInstance.Status.ConditionedStatus, pgInstance.Status.AtProvider.LastBackupTime, pg.CreatedAT, pgInstance.Spec.ForProvider.MaxBackupAge, time.Now())
+	err = observeUpgrade(*pg, pgInstance, previousUpgrade)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 
 	caCert, err := p.exo.GetDBAASCACertificate(ctx)
 	if err != nil {
@@ -60,14 +78,32 @@ func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, "cannot read connection details")
 	}
 
-	currentParams, err := setSettingsDefaults(ctx, *p.exo, &pgInstance.Spec.ForProvider)
+	currentParams := &pgInstance.Spec.ForProvider
+	if currentParams.SettingsPolicy != exoscalev1.SettingsPolicyManagedKeysOnly {
+		// Unset settings are expected to have their default value.
+		currentParams, err = setSettingsDefaults(ctx, *p.exo, &pgInstance.Spec.ForProvider)
+		if err != nil {
+			log.Error(err, "unable to set postgres settings schema")
+			currentParams = &pgInstance.Spec.ForProvider
+		}
+	}
+	endpoints, endpointsUpToDate, err := externalendpoint.Observe(ctx, p.kube, p.exo, &pgInstance.Status.ConditionedStatus, pgInstance.GetInstanceName(), pgInstance.Spec.ForProvider.ExternalEndpoints)
 	if err != nil {
-		log.Error(err, "unable to set postgres settings schema")
-		currentParams = &pgInstance.Spec.ForProvider
+		return managed.ExternalObservation{}, err
 	}
+	pgInstance.Status.AtProvider.ExternalEndpoints = endpoints
+
+	migrationStatus, migrationUpToDate, err := migration.Observe(ctx, p.kube, p.exo, pgInstance.GetInstanceName(), pgInstance.Spec.ForProvider.Migration, previousMigration)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	pgInstance.Status.AtProvider.Migration = migrationStatus
+
+	pgInstance.Status.AtProvider.Triggers = previousTriggers
+
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  isUpToDate(currentParams, params, log),
+		ResourceUpToDate:  isUpToDate(currentParams, params, log) && endpointsUpToDate && migrationUpToDate && trigger.IsUpToDate(pgInstance, previousTriggers),
 		ConnectionDetails: connDetails,
 	}, nil
 }
@@ -82,6 +118,19 @@ func mapParameters(in *exoscalesdk.DBAASServicePG, zone exoscalev1.Zone) (*exosc
 
 	settings := runtime.RawExtension{Raw: jsonSettings}
 
+	pgbouncerSettings, err := fromSettings(in.PgbouncerSettings)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse pgbouncer settings: %w", err)
+	}
+	pglookoutSettings, err := fromSettings(in.PglookoutSettings)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse pglookout settings: %w", err)
+	}
+	timescaledbSettings, err := fromSettings(in.TimescaledbSettings)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse timescaledb settings: %w", err)
+	}
+
 	return &exoscalev1.PostgreSQLParameters{
 		Maintenance: exoscalev1.MaintenanceSpec{
 			DayOfWeek: in.Maintenance.Dow,
@@ -96,8 +145,14 @@ func mapParameters(in *exoscalesdk.DBAASServicePG, zone exoscalev1.Zone) (*exosc
 			},
 			IPFilter: in.IPFilter,
 		},
-		Version:    in.Version,
-		PGSettings: settings,
+		Version:                 in.Version,
+		PGSettings:              settings,
+		PgBouncerSettings:       pgbouncerSettings,
+		PGLookoutSettings:       pglookoutSettings,
+		TimescaleDBSettings:     timescaledbSettings,
+		SharedBuffersPercentage: in.SharedBuffersPercentage,
+		WorkMem:                 in.WorkMem,
+		SynchronousReplication:  string(in.SynchronousReplication),
 	}, nil
 }
 
@@ -138,11 +193,31 @@ func mapObservation(instance *exoscalesdk.DBAASServicePG) (exoscalev1.PostgreSQL
 			DayOfWeek: instance.Maintenance.Dow,
 			TimeOfDay: exoscalev1.TimeOfDay(instance.Maintenance.Time),
 		},
-		Backup:     toBackupSpec(instance.BackupSchedule),
-		NodeStates: mapper.ToNodeStates(&instance.NodeStates),
+		Backup:                  toBackupSpec(instance.BackupSchedule),
+		NodeStates:              mapper.ToNodeStates(&instance.NodeStates),
+		SharedBuffersPercentage: instance.SharedBuffersPercentage,
+		WorkMem:                 instance.WorkMem,
+		SynchronousReplication:  string(instance.SynchronousReplication),
 	}
+	observation.Backups, observation.LastBackupTime = mapper.ToBackups(instance.Backups)
 
 	observation.PGSettings = settings
+	observation.PgBouncerSettings, err = fromSettings(instance.PgbouncerSettings)
+	if err != nil {
+		return exoscalev1.PostgreSQLObservation{}, fmt.Errorf("error parsing PgbouncerSettings")
+	}
+	observation.PGLookoutSettings, err = fromSettings(instance.PglookoutSettings)
+	if err != nil {
+		return exoscalev1.PostgreSQLObservation{}, fmt.Errorf("error parsing PglookoutSettings")
+	}
+	observation.TimescaleDBSettings, err = fromSettings(instance.TimescaledbSettings)
+	if err != nil {
+		return exoscalev1.PostgreSQLObservation{}, fmt.Errorf("error parsing TimescaledbSettings")
+	}
+	observation.Notifications, err = mapper.ToNotifications(instance.Notifications)
+	if err != nil {
+		return exoscalev1.PostgreSQLObservation{}, fmt.Errorf("error parsing notifications: %w", err)
+	}
 
 	return observation, nil
 }
@@ -158,13 +233,20 @@ func isUpToDate(current, external *exoscalev1.PostgreSQLParameters, log logr.Log
 	}
 	extIPFilter := []string(external.IPFilter)
 	checks := map[string]bool{
-		"IPFilter":              mapper.IsSameStringSet(current.IPFilter, &extIPFilter),
+		"IPFilter":              mapper.IsSameIPFilter(current.IPFilter, &extIPFilter),
 		"MajorVersion":          sameMajorVersion,
 		"Maintenance":           current.Maintenance.Equals(external.Maintenance),
 		"BackupSchedule":        current.Backup.Equals(external.Backup),
 		"Size":                  current.Size.Equals(external.Size),
 		"TerminationProtection": current.TerminationProtection == external.TerminationProtection,
-		"PGSettings":            mapper.CompareSettings(current.PGSettings, external.PGSettings),
+		"PGSettings":            mapper.CompareSettingsWithPolicy(current.SettingsPolicy, current.PGSettings, external.PGSettings),
+		"PgBouncerSettings":     mapper.CompareSettingsWithPolicy(current.SettingsPolicy, current.PgBouncerSettings, withObservedZeros(current.PgBouncerSettings, external.PgBouncerSettings)),
+		"PGLookoutSettings":     mapper.CompareSettingsWithPolicy(current.SettingsPolicy, current.PGLookoutSettings, withObservedZeros(current.PGLookoutSettings, external.PGLookoutSettings)),
+		"TimescaleDBSettings":   mapper.CompareSettingsWithPolicy(current.SettingsPolicy, current.TimescaleDBSettings, withObservedZeros(current.TimescaleDBSettings, external.TimescaleDBSettings)),
+		// The provider's defaults apply to unset values.
+		"SharedBuffersPercentage": current.SharedBuffersPercentage == 0 || current.SharedBuffersPercentage == external.SharedBuffersPercentage,
+		"WorkMem":                 current.WorkMem == 0 || current.WorkMem == external.WorkMem,
+		"SynchronousReplication":  current.SynchronousReplication == "" || current.SynchronousReplication == external.SynchronousReplication,
 	}
 	ok := true
 	for _, v := range checks {
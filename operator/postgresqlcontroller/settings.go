@@ -1,13 +1,18 @@
 package postgresqlcontroller
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"io"
+	"net/http"
 
 	exoscalesdk "github.com/exoscale/egoscale/v3"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vshn/provider-exoscale/internal/settings"
+	"github.com/vshn/provider-exoscale/operator/mapper"
 )
 
 type settingsFetcher interface {
@@ -25,6 +30,18 @@ func setSettingsDefaults(ctx context.Context, f settingsFetcher, in *exoscalev1.
 	if err != nil {
 		return nil, err
 	}
+	res.PgBouncerSettings, err = s.SetDefaults("pgbouncer", res.PgBouncerSettings)
+	if err != nil {
+		return nil, err
+	}
+	res.PGLookoutSettings, err = s.SetDefaults("pglookout", res.PGLookoutSettings)
+	if err != nil {
+		return nil, err
+	}
+	res.TimescaleDBSettings, err = s.SetDefaults("timescaledb", res.TimescaleDBSettings)
+	if err != nil {
+		return nil, err
+	}
 
 	return res, nil
 }
@@ -44,3 +61,101 @@ func fetchSettingSchema(ctx context.Context, f settingsFetcher) (settings.Schema
 	}
 	return schemas, nil
 }
+
+// toSettings parses the given settings into the request type.
+// Returns nil if no settings are given, so that the settings are left untouched.
+func toSettings[T any](raw runtime.RawExtension) (*T, error) {
+	if len(raw.Raw) == 0 {
+		return nil, nil
+	}
+	settings := new(T)
+	err := json.Unmarshal(raw.Raw, settings)
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// fromSettings converts the given settings of the response body.
+func fromSettings[T any](settings *T) (runtime.RawExtension, error) {
+	if settings == nil {
+		return runtime.RawExtension{}, nil
+	}
+	raw, err := json.Marshal(settings)
+	if err != nil {
+		return runtime.RawExtension{}, err
+	}
+	return runtime.RawExtension{Raw: raw}, nil
+}
+
+// rawSettings returns a request interceptor that sends the pgbouncer, pglookout and timescaledb settings of
+// create and update requests as given in the spec.
+// The typed settings of the request drop zero values, although e.g. an autodb_idle_timeout of 0 disables the timeout.
+func rawSettings(spec exoscalev1.PostgreSQLParameters) exoscalesdk.RequestInterceptorFn {
+	groups := map[string]runtime.RawExtension{
+		"pgbouncer-settings":   spec.PgBouncerSettings,
+		"pglookout-settings":   spec.PGLookoutSettings,
+		"timescaledb-settings": spec.TimescaleDBSettings,
+	}
+	return func(_ context.Context, req *http.Request) error {
+		if req.Body == nil {
+			return nil
+		}
+		raw, err := io.ReadAll(req.Body)
+		if err != nil {
+			return err
+		}
+		body := map[string]json.RawMessage{}
+		err = json.Unmarshal(raw, &body)
+		if err != nil {
+			return err
+		}
+		for key, settings := range groups {
+			if len(settings.Raw) != 0 {
+				body[key] = settings.Raw
+			}
+		}
+		raw, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(raw))
+		req.ContentLength = int64(len(raw))
+		return nil
+	}
+}
+
+// withObservedZeros returns the observed settings with the desired zero values added where they're missing.
+// The typed settings of the response drop zero values, so a missing value can't be told apart from zero.
+// The observed settings are returned unchanged if either settings can't be parsed.
+func withObservedZeros(desired, observed runtime.RawExtension) runtime.RawExtension {
+	d, err := mapper.ToMap(desired)
+	if err != nil {
+		return observed
+	}
+	o, err := mapper.ToMap(observed)
+	if err != nil {
+		return observed
+	}
+	for k, v := range d {
+		if _, ok := o[k]; !ok && isZero(v) {
+			o[k] = v
+		}
+	}
+	res, err := mapper.ToRawExtension(&o)
+	if err != nil {
+		return observed
+	}
+	return res
+}
+
+// isZero returns true if the given setting value is the number zero.
+func isZero(v interface{}) bool {
+	switch n := v.(type) {
+	case int64:
+		return n == 0
+	case float64:
+		return n == 0
+	}
+	return false
+}
@@ -1,7 +1,10 @@
 package postgresqlcontroller
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"net/http"
 	"testing"
 
 	exoscalesdk "github.com/exoscale/egoscale/v3"
@@ -51,6 +54,47 @@ func TestDefaultSettings(t *testing.T) {
 	assert.Len(t, setingsWithDefaults, 1)
 }
 
+func TestDefaultSettingsGroups(t *testing.T) {
+	found := exoscalev1.PostgreSQLParameters{
+		Zone: "gva-2",
+		PgBouncerSettings: mustToRawExt(t, map[string]interface{}{
+			"autodb_pool_mode": "transaction",
+		}),
+	}
+
+	withDefaults, err := setSettingsDefaults(context.Background(), fakeSettingsFetcher{}, &found)
+	require.NoError(t, err, "failed to set defaults")
+
+	pgbouncer, err := mapper.ToMap(withDefaults.PgBouncerSettings)
+	require.NoError(t, err, "failed to parse set defaults")
+	assert.Equal(t, map[string]interface{}{"autodb_pool_mode": "transaction"}, pgbouncer)
+	pglookout, err := mapper.ToMap(withDefaults.PGLookoutSettings)
+	require.NoError(t, err, "failed to parse set defaults")
+	assert.EqualValues(t, 60, pglookout["max_failover_replication_time_lag"])
+	timescaledb, err := mapper.ToMap(withDefaults.TimescaleDBSettings)
+	require.NoError(t, err, "failed to parse set defaults")
+	assert.Empty(t, timescaledb)
+}
+
+func TestSettingsRoundTrip(t *testing.T) {
+	raw := mustToRawExt(t, map[string]interface{}{"autodb_pool_mode": "transaction", "autodb_pool_size": 10})
+
+	settings, err := toSettings[exoscalesdk.JSONSchemaPgbouncer](raw)
+	require.NoError(t, err)
+	assert.Equal(t, &exoscalesdk.JSONSchemaPgbouncer{AutodbPoolMode: "transaction", AutodbPoolSize: 10}, settings)
+
+	back, err := fromSettings(settings)
+	require.NoError(t, err)
+	assert.True(t, mapper.CompareSettings(raw, back))
+
+	settings, err = toSettings[exoscalesdk.JSONSchemaPgbouncer](runtime.RawExtension{})
+	require.NoError(t, err)
+	assert.Nil(t, settings)
+	back, err = fromSettings(settings)
+	require.NoError(t, err)
+	assert.Empty(t, back.Raw)
+}
+
 var pgSettings = exoscalesdk.GetDBAASSettingsPGResponseSettings{
 	PG: &exoscalesdk.GetDBAASSettingsPGResponseSettingsPG{
 		Properties: map[string]any{
@@ -558,3 +602,33 @@ var pgSettings = exoscalesdk.GetDBAASSettingsPGResponseSettings{
 		Title:                "TimescaleDB extension configuration values",
 	},
 }
+
+func TestRawSettings(t *testing.T) {
+	spec := exoscalev1.PostgreSQLParameters{
+		Backup:            exoscalev1.BackupSpec{TimeOfDay: "12:00:00"},
+		PgBouncerSettings: mustToRawExt(t, map[string]interface{}{"autodb_idle_timeout": 0, "autodb_pool_size": 10}),
+	}
+	body, err := fromSpecToUpdateBody(spec)
+	require.NoError(t, err)
+	raw, err := json.Marshal(body)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPut, "https://api.exoscale.com/v2/dbaas-postgres/pg", bytes.NewReader(raw))
+	require.NoError(t, err)
+
+	require.NoError(t, rawSettings(spec)(context.TODO(), req))
+	sent := map[string]json.RawMessage{}
+	require.NoError(t, json.NewDecoder(req.Body).Decode(&sent))
+	assert.JSONEq(t, `{"autodb_idle_timeout":0,"autodb_pool_size":10}`, string(sent["pgbouncer-settings"]))
+	assert.NotContains(t, sent, "pglookout-settings")
+	assert.Contains(t, sent, "variant")
+}
+
+func TestWithObservedZeros(t *testing.T) {
+	desired := mustToRawExt(t, map[string]interface{}{"autodb_idle_timeout": 0, "autodb_pool_size": 10, "min_pool_size": 0})
+	observed := mustToRawExt(t, map[string]interface{}{"autodb_pool_size": 10, "min_pool_size": 5})
+
+	assert.True(t, mapper.CompareSettings(
+		mustToRawExt(t, map[string]interface{}{"autodb_idle_timeout": 0, "autodb_pool_size": 10, "min_pool_size": 5}),
+		withObservedZeros(desired, observed),
+	))
+}
@@ -25,6 +25,7 @@ func SetupController(mgr ctrl.Manager) error {
 			kube:     mgr.GetClient(),
 			recorder: recorder,
 		}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(logging.NewLogrLogger(mgr.GetLogger().WithValues("controller", name))),
 		managed.WithRecorder(recorder),
 		managed.WithPollInterval(1*time.Minute),
@@ -10,7 +10,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	exoscalesdk "github.com/exoscale/egoscale/v3"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/externalendpoint"
 	"github.com/vshn/provider-exoscale/operator/mapper"
+	"github.com/vshn/provider-exoscale/operator/migration"
+	"github.com/vshn/provider-exoscale/operator/trigger"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 )
 
@@ -21,16 +24,52 @@ func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	pgInstance := mg.(*exoscalev1.PostgreSQL)
 
+	pgInstance.Status.AtProvider.Triggers = trigger.Handle(ctx, p.recorder, pgInstance, pgInstance.GetInstanceName(), trigger.Actions{
+		trigger.MaintenanceAnnotation: p.exo.StartDBAASPGMaintenance,
+	}, pgInstance.Status.AtProvider.Triggers)
+
+	upgrade, err := needsUpgrade(pgInstance)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot compare version")
+	}
+	if upgrade {
+		// Other changes are applied once the upgrade has finished.
+		return managed.ExternalUpdate{}, p.upgrade(ctx, pgInstance)
+	}
+
 	spec := pgInstance.Spec.ForProvider
 	body, err := fromSpecToUpdateBody(spec)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot map spec to API request")
 	}
-	resp, err := p.exo.UpdateDBAASServicePG(ctx, pgInstance.Name, body)
+	observedMigration := pgInstance.Status.AtProvider.Migration
+	migrationReq, migrationVersion, err := migration.ToRequest(ctx, p.kube, spec.Migration)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot map migration to API request")
+	}
+	if spec.Migration != nil && !migration.IsUpToDate(spec.Migration, migrationVersion, observedMigration) {
+		// Only send the migration if it changed, as it restarts the migration.
+		body.Migration = (*exoscalesdk.UpdateDBAASServicePGRequestMigration)(migrationReq)
+	}
+	resp, err := p.exo.WithRequestInterceptor(rawSettings(spec)).UpdateDBAASServicePG(ctx, pgInstance.Name, body)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update instance")
 	}
 	log.V(1).Info("Response", "message", resp.Message)
+	if body.Migration != nil {
+		pgInstance.Status.AtProvider.Migration = migration.Applied(observedMigration, spec.Migration, migrationVersion)
+	}
+	if spec.Migration == nil && !migration.IsUpToDate(nil, "", observedMigration) {
+		_, err = p.exo.StopDBAASPGMigration(ctx, pgInstance.GetInstanceName())
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, "cannot stop migration")
+		}
+		pgInstance.Status.AtProvider.Migration = migration.Applied(observedMigration, nil, "")
+	}
+	err = externalendpoint.Update(ctx, p.kube, p.exo, pgInstance.GetInstanceName(), spec.ExternalEndpoints, pgInstance.Status.AtProvider.ExternalEndpoints)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -51,6 +90,18 @@ func fromSpecToUpdateBody(spec exoscalev1.PostgreSQLParameters) (exoscalesdk.Upd
 			return exoscalesdk.UpdateDBAASServicePGRequest{}, fmt.Errorf("invalid pgsettings: %w", err)
 		}
 	}
+	pgbouncerSettings, err := toSettings[exoscalesdk.JSONSchemaPgbouncer](spec.PgBouncerSettings)
+	if err != nil {
+		return exoscalesdk.UpdateDBAASServicePGRequest{}, fmt.Errorf("invalid pgbouncer settings: %w", err)
+	}
+	pglookoutSettings, err := toSettings[exoscalesdk.JSONSchemaPglookout](spec.PGLookoutSettings)
+	if err != nil {
+		return exoscalesdk.UpdateDBAASServicePGRequest{}, fmt.Errorf("invalid pglookout settings: %w", err)
+	}
+	timescaledbSettings, err := toSettings[exoscalesdk.JSONSchemaTimescaledb](spec.TimescaleDBSettings)
+	if err != nil {
+		return exoscalesdk.UpdateDBAASServicePGRequest{}, fmt.Errorf("invalid timescaledb settings: %w", err)
+	}
 
 	return exoscalesdk.UpdateDBAASServicePGRequest{
 		Plan: spec.Size.Plan,
@@ -59,13 +110,19 @@ func fromSpecToUpdateBody(spec exoscalev1.PostgreSQLParameters) (exoscalesdk.Upd
 			BackupMinute: backupSchedule.BackupMinute,
 		},
 		Variant: variantAiven,
-		// Version: pointer.String(spec.Version) -> Version cannot be changed,
+		// Version: major upgrades are requested separately, see upgrade()
 		TerminationProtection: &spec.TerminationProtection,
 		Maintenance: &exoscalesdk.UpdateDBAASServicePGRequestMaintenance{
 			Dow:  exoscalesdk.UpdateDBAASServicePGRequestMaintenanceDow(spec.Maintenance.DayOfWeek),
 			Time: spec.Maintenance.TimeOfDay.String(),
 		},
-		IPFilter:   spec.IPFilter,
-		PGSettings: settings,
+		IPFilter:                spec.IPFilter,
+		PGSettings:              settings,
+		PgbouncerSettings:       pgbouncerSettings,
+		PglookoutSettings:       pglookoutSettings,
+		TimescaledbSettings:     timescaledbSettings,
+		SharedBuffersPercentage: spec.SharedBuffersPercentage,
+		WorkMem:                 spec.WorkMem,
+		SynchronousReplication:  exoscalesdk.EnumPGSynchronousReplication(spec.SynchronousReplication),
 	}, nil
 }
@@ -0,0 +1,108 @@
+package postgresqlcontroller
+
+import (
+	"context"
+	"fmt"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/mapper"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// needsUpgrade returns true if the desired major version is newer than the observed one.
+func needsUpgrade(pgInstance *exoscalev1.PostgreSQL) (bool, error) {
+	desired, observed := pgInstance.Spec.ForProvider.Version, pgInstance.Status.AtProvider.Version
+	if desired == "" || observed == "" {
+		return false, nil
+	}
+	return mapper.IsMajorUpgrade(desired, observed)
+}
+
+// isUpgrading returns true if an upgrade has been requested and hasn't finished yet.
+func isUpgrading(pgInstance *exoscalev1.PostgreSQL) bool {
+	return pgInstance.GetCondition(exoscalev1.TypeUpgrading).Reason == exoscalev1.ReasonUpgradeInProgress
+}
+
+// upgrade runs the pre-flight upgrade check and requests the major version upgrade once the check succeeded.
+// The check runs asynchronously, its task is tracked in the status and polled on subsequent reconciliations.
+func (p *pipeline) upgrade(ctx context.Context, pgInstance *exoscalev1.PostgreSQL) error {
+	log := controllerruntime.LoggerFrom(ctx)
+	target := pgInstance.Spec.ForProvider.Version
+	status := pgInstance.Status.AtProvider.Upgrade
+
+	if status != nil && status.TargetVersion == target && isUpgrading(pgInstance) {
+		log.V(1).Info("Upgrade in progress", "version", target)
+		return nil
+	}
+	if status != nil && status.TargetVersion == target && status.CheckFailed {
+		return fmt.Errorf("upgrade check to version %s failed: %s", target, status.CheckResult)
+	}
+
+	var task *exoscalesdk.DBAASTask
+	var err error
+	if status == nil || status.TargetVersion != target {
+		log.Info("Checking upgrade", "version", target)
+		task, err = p.exo.CreateDBAASPGUpgradeCheck(ctx, pgInstance.GetInstanceName(), exoscalesdk.CreateDBAASPGUpgradeCheckRequest{
+			TargetVersion: exoscalesdk.DBAASPGTargetVersions(target),
+		})
+		if err != nil {
+			return fmt.Errorf("cannot check upgrade to version %s: %w", target, err)
+		}
+		pgInstance.Status.AtProvider.Upgrade = &exoscalev1.PostgreSQLUpgradeStatus{
+			TargetVersion: target,
+			CheckTaskID:   string(task.ID),
+		}
+	} else {
+		task, err = p.exo.GetDBAASTask(ctx, pgInstance.GetInstanceName(), exoscalesdk.UUID(status.CheckTaskID))
+		if err != nil {
+			return fmt.Errorf("cannot get upgrade check: %w", err)
+		}
+	}
+
+	switch {
+	case task.Success == nil:
+		pgInstance.SetConditions(exoscalev1.UpgradeCheckPending(target))
+		return nil
+	case !*task.Success:
+		// Remember the failed check so that it isn't run again until another version is requested.
+		pgInstance.Status.AtProvider.Upgrade.CheckFailed = true
+		pgInstance.Status.AtProvider.Upgrade.CheckResult = task.Result
+		pgInstance.SetConditions(exoscalev1.UpgradeCheckFailed(target, task.Result))
+		return fmt.Errorf("upgrade check to version %s failed: %s", target, task.Result)
+	}
+
+	log.Info("Upgrading instance", "version", target)
+	resp, err := p.exo.UpdateDBAASServicePG(ctx, pgInstance.GetInstanceName(), exoscalesdk.UpdateDBAASServicePGRequest{
+		Version: target,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot upgrade instance to version %s: %w", target, err)
+	}
+	log.V(1).Info("Response", "message", resp.Message)
+	pgInstance.SetConditions(exoscalev1.UpgradeInProgress(target))
+	return nil
+}
+
+// observeUpgrade marks a requested upgrade as complete once the instance runs the target version.
+// A failed upgrade check is forgotten once another version is requested.
+func observeUpgrade(pg exoscalesdk.DBAASServicePG, pgInstance *exoscalev1.PostgreSQL, previous *exoscalev1.PostgreSQLUpgradeStatus) error {
+	pgInstance.Status.AtProvider.Upgrade = previous
+	if previous != nil && previous.CheckFailed && previous.TargetVersion != pgInstance.Spec.ForProvider.Version {
+		pgInstance.Status.AtProvider.Upgrade = nil
+		mapper.RemoveStatusCondition(&pgInstance.Status.ConditionedStatus, exoscalev1.TypeUpgrading)
+		return nil
+	}
+	if previous == nil || !isUpgrading(pgInstance) || pg.State != exoscalesdk.EnumServiceStateRunning {
+		return nil
+	}
+	upgraded, err := mapper.CompareMajorVersion(previous.TargetVersion, pg.Version)
+	if err != nil {
+		return fmt.Errorf("cannot compare version: %w", err)
+	}
+	if upgraded {
+		pgInstance.Status.AtProvider.Upgrade = nil
+		pgInstance.SetConditions(exoscalev1.UpgradeComplete(previous.TargetVersion))
+	}
+	return nil
+}
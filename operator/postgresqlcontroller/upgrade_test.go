@@ -0,0 +1,119 @@
+package postgresqlcontroller
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestNeedsUpgrade(t *testing.T) {
+	tests := map[string]struct {
+		desired  string
+		observed string
+		expected bool
+	}{
+		"NotObservedYet": {desired: "15", observed: "", expected: false},
+		"SameMajor":      {desired: "14", observed: "14.5", expected: false},
+		"NewerMajor":     {desired: "15", observed: "14.5", expected: true},
+		"OlderMajor":     {desired: "14", observed: "15.1", expected: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pgInstance := &exoscalev1.PostgreSQL{}
+			pgInstance.Spec.ForProvider.Version = tc.desired
+			pgInstance.Status.AtProvider.Version = tc.observed
+
+			result, err := needsUpgrade(pgInstance)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestObserveUpgrade(t *testing.T) {
+	tests := map[string]struct {
+		givenCondition    *xpv1.Condition
+		givenUpgrade      *exoscalev1.PostgreSQLUpgradeStatus
+		givenDesired      string
+		givenState        exoscalesdk.EnumServiceState
+		givenVersion      string
+		expectedReason    xpv1.ConditionReason
+		expectedUpgrading bool
+	}{
+		"NoUpgrade": {
+			givenState:   exoscalesdk.EnumServiceStateRunning,
+			givenVersion: "14.5",
+		},
+		"CheckPending": {
+			givenCondition:    ptr.To(exoscalev1.UpgradeCheckPending("15")),
+			givenUpgrade:      &exoscalev1.PostgreSQLUpgradeStatus{TargetVersion: "15", CheckTaskID: "task"},
+			givenState:        exoscalesdk.EnumServiceStateRunning,
+			givenVersion:      "14.5",
+			expectedReason:    exoscalev1.ReasonUpgradeCheckPending,
+			expectedUpgrading: true,
+		},
+		"CheckFailed": {
+			givenCondition:    ptr.To(exoscalev1.UpgradeCheckFailed("15", "extension not supported")),
+			givenUpgrade:      &exoscalev1.PostgreSQLUpgradeStatus{TargetVersion: "15", CheckTaskID: "task", CheckFailed: true, CheckResult: "extension not supported"},
+			givenDesired:      "15",
+			givenState:        exoscalesdk.EnumServiceStateRunning,
+			givenVersion:      "14.5",
+			expectedReason:    exoscalev1.ReasonUpgradeCheckFailed,
+			expectedUpgrading: true,
+		},
+		"CheckFailed_OtherVersion": {
+			givenCondition: ptr.To(exoscalev1.UpgradeCheckFailed("15", "extension not supported")),
+			givenUpgrade:   &exoscalev1.PostgreSQLUpgradeStatus{TargetVersion: "15", CheckTaskID: "task", CheckFailed: true, CheckResult: "extension not supported"},
+			givenDesired:   "16",
+			givenState:     exoscalesdk.EnumServiceStateRunning,
+			givenVersion:   "14.5",
+		},
+		"StillRebuilding": {
+			givenCondition:    ptr.To(exoscalev1.UpgradeInProgress("15")),
+			givenUpgrade:      &exoscalev1.PostgreSQLUpgradeStatus{TargetVersion: "15", CheckTaskID: "task"},
+			givenState:        exoscalesdk.EnumServiceStateRebuilding,
+			givenVersion:      "15.1",
+			expectedReason:    exoscalev1.ReasonUpgradeInProgress,
+			expectedUpgrading: true,
+		},
+		"Upgraded": {
+			givenCondition: ptr.To(exoscalev1.UpgradeInProgress("15")),
+			givenUpgrade:   &exoscalev1.PostgreSQLUpgradeStatus{TargetVersion: "15", CheckTaskID: "task"},
+			givenState:     exoscalesdk.EnumServiceStateRunning,
+			givenVersion:   "15.1",
+			expectedReason: exoscalev1.ReasonUpgradeComplete,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pgInstance := &exoscalev1.PostgreSQL{}
+			pgInstance.Spec.ForProvider.Version = tc.givenDesired
+			if tc.givenCondition != nil {
+				pgInstance.SetConditions(*tc.givenCondition)
+			}
+			pg := exoscalesdk.DBAASServicePG{State: tc.givenState, Version: tc.givenVersion}
+
+			err := observeUpgrade(pg, pgInstance, tc.givenUpgrade)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReason, pgInstance.GetCondition(exoscalev1.TypeUpgrading).Reason)
+			assert.Equal(t, tc.expectedUpgrading, pgInstance.Status.AtProvider.Upgrade != nil)
+		})
+	}
+}
+
+func TestUpgrade_CheckFailed(t *testing.T) {
+	pgInstance := &exoscalev1.PostgreSQL{}
+	pgInstance.Spec.ForProvider.Version = "15"
+	pgInstance.Status.AtProvider.Upgrade = &exoscalev1.PostgreSQLUpgradeStatus{TargetVersion: "15", CheckTaskID: "task", CheckFailed: true, CheckResult: "extension not supported"}
+
+	// The pipeline has no client, the failed check must not be run again.
+	err := (&pipeline{}).upgrade(context.TODO(), pgInstance)
+	assert.EqualError(t, err, "upgrade check to version 15 failed: extension not supported")
+	assert.Equal(t, "task", pgInstance.Status.AtProvider.Upgrade.CheckTaskID)
+}
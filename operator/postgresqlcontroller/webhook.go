@@ -9,12 +9,14 @@ import (
 	exoscalesdk "github.com/exoscale/egoscale/v3"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
 	"github.com/vshn/provider-exoscale/operator/common"
+	"github.com/vshn/provider-exoscale/operator/mapper"
 	"github.com/vshn/provider-exoscale/operator/pipelineutil"
 	"github.com/vshn/provider-exoscale/operator/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 const serviceType = "pg"
@@ -33,23 +35,36 @@ func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (adm
 	}
 	v.log.V(1).Info("Validate create")
 
-	availableVersions, err := v.getAvailableVersions(ctx, obj)
+	dbaasType, err := v.getServiceType(ctx, obj)
 	if err != nil {
 		return nil, err
 	}
 
-	err = v.validateVersion(ctx, obj, availableVersions)
+	err = v.validateVersion(ctx, obj, dbaasType.AvailableVersions)
 	if err != nil {
 		return nil, err
 	}
 
-	return nil, v.validateSpec(instance)
+	err = webhook.ValidatePlan(instance.Spec.ForProvider.Size.Plan, instance.Spec.ForProvider.Zone.String(), dbaasType.Plans)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateIpFilter(instance.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	err = v.validateSpec(instance)
+	if err != nil {
+		return nil, err
+	}
+	return nil, v.validateSettingsSchema(ctx, nil, instance)
 }
 
-func (v *Validator) getAvailableVersions(ctx context.Context, obj runtime.Object) ([]string, error) {
+func (v *Validator) getServiceType(ctx context.Context, obj runtime.Object) (*exoscalesdk.DBAASServiceType, error) {
 	instance := obj.(*exoscalev1.PostgreSQL)
 
-	v.log.V(1).Info("get postgres available versions")
+	v.log.V(1).Info("get postgres service type")
 	exo, err := pipelineutil.OpenExoscaleClient(ctx, v.kube, instance.GetProviderConfigName(), exoscalesdk.ClientOptWithEndpoint(common.ZoneTranslation[instance.Spec.ForProvider.Zone]))
 	if err != nil {
 		return nil, fmt.Errorf("open exoscale client failed: %w", err)
@@ -61,13 +76,33 @@ func (v *Validator) getAvailableVersions(ctx context.Context, obj runtime.Object
 	}
 
 	v.log.V(1).Info("DBaaS service type", "name", string(resp.Name), "description", string(resp.Description))
+	return resp, nil
+}
 
+func (v *Validator) getAvailableVersions(ctx context.Context, obj runtime.Object) ([]string, error) {
+	resp, err := v.getServiceType(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
 	if resp.AvailableVersions == nil {
 		return nil, fmt.Errorf("postgres available versions not found")
 	}
 	return resp.AvailableVersions, nil
 }
 
+// validatePlanChange validates a changed plan and warns about downgrades.
+func (v *Validator) validatePlanChange(ctx context.Context, oldInst, newInst exoscalev1.PostgreSQL) (admission.Warnings, error) {
+	oldPlan, newPlan := oldInst.Spec.ForProvider.Size.Plan, newInst.Spec.ForProvider.Size.Plan
+	if oldPlan == newPlan {
+		return nil, nil
+	}
+	dbaasType, err := v.getServiceType(ctx, &newInst)
+	if err != nil {
+		return nil, err
+	}
+	return webhook.ValidatePlanChange(oldPlan, newPlan, newInst.Spec.ForProvider.Zone.String(), dbaasType.Plans)
+}
+
 func (v *Validator) validateVersion(ctx context.Context, obj runtime.Object, availableVersions []string) error {
 	instance := obj.(*exoscalev1.PostgreSQL)
 
@@ -76,7 +111,7 @@ func (v *Validator) validateVersion(ctx context.Context, obj runtime.Object, ava
 }
 
 // ValidateUpdate implements admission.CustomValidator.
-func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	newInstance, ok := newObj.(*exoscalev1.PostgreSQL)
 	if !ok {
 		return nil, fmt.Errorf("invalid managed resource type %T for postgres webhook", newObj)
@@ -87,24 +122,45 @@ func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Obj
 	}
 	v.log.V(1).Info("Validate update")
 
-	err := v.validateSpec(newInstance)
+	err := webhook.ValidateIPFilterUpdate(oldInstance.Spec.ForProvider.IPFilter, newInstance.Spec.ForProvider.IPFilter)
+	if err != nil {
+		return nil, err
+	}
+	err = v.validateSpec(newInstance)
 	if err != nil {
 		return nil, err
 	}
-	return nil, validateImmutable(*oldInstance, *newInstance)
+	err = validateImmutable(*oldInstance, *newInstance)
+	if err != nil {
+		return nil, err
+	}
+	err = v.validateSettingsSchema(ctx, oldInstance, newInstance)
+	if err != nil {
+		return nil, err
+	}
+	warnings, err := v.validatePlanChange(ctx, *oldInstance, *newInstance)
+	if err != nil {
+		return nil, err
+	}
+	return warnings, v.validateUpgrade(ctx, *oldInstance, *newInstance)
 }
 
 // ValidateDelete implements admission.CustomValidator.
-func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
-	v.log.V(1).Info("Validate delete (noop)")
-	return nil, nil
+func (v *Validator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	instance, ok := obj.(*exoscalev1.PostgreSQL)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for postgres webhook", obj)
+	}
+	v.log.V(1).Info("Validate delete")
+	return nil, webhook.ValidateTerminationProtection(instance, instance.Spec.ForProvider.TerminationProtection, instance.Status.AtProvider.TerminationProtection)
 }
 
 func (v *Validator) validateSpec(obj *exoscalev1.PostgreSQL) error {
 	for _, validatorFn := range []func(exoscalev1.PostgreSQLParameters) error{
-		validateIpFilter,
 		validateMaintenanceSchedule,
 		validatePGSettings,
+		validateRecovery,
+		validateMigration,
 	} {
 		if err := validatorFn(obj.Spec.ForProvider); err != nil {
 			return err
@@ -114,10 +170,7 @@ func (v *Validator) validateSpec(obj *exoscalev1.PostgreSQL) error {
 }
 
 func validateIpFilter(obj exoscalev1.PostgreSQLParameters) error {
-	if len(obj.IPFilter) == 0 {
-		return fmt.Errorf("IP filter cannot be empty")
-	}
-	return nil
+	return webhook.ValidateIPFilter(obj.IPFilter)
 }
 
 func validateMaintenanceSchedule(obj exoscalev1.PostgreSQLParameters) error {
@@ -128,7 +181,62 @@ func validateMaintenanceSchedule(obj exoscalev1.PostgreSQLParameters) error {
 }
 
 func validatePGSettings(obj exoscalev1.PostgreSQLParameters) error {
-	return webhook.ValidateRawExtension(obj.PGSettings)
+	for _, s := range []runtime.RawExtension{obj.PGSettings, obj.PgBouncerSettings, obj.PGLookoutSettings, obj.TimescaleDBSettings} {
+		if err := webhook.ValidateRawExtension(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSettingsSchema validates new or changed settings against the settings schema of the service.
+// oldInst is nil on creation.
+func (v *Validator) validateSettingsSchema(ctx context.Context, oldInst, newInst *exoscalev1.PostgreSQL) error {
+	var previous []webhook.Setting
+	if oldInst != nil {
+		previous = settingsOf(oldInst.Spec.ForProvider)
+	}
+	changed := webhook.ChangedSettings(previous, settingsOf(newInst.Spec.ForProvider))
+	if len(changed) == 0 {
+		return nil
+	}
+	exo, err := pipelineutil.OpenExoscaleClient(ctx, v.kube, newInst.GetProviderConfigName(), exoscalesdk.ClientOptWithEndpoint(common.ZoneTranslation[newInst.Spec.ForProvider.Zone]))
+	if err != nil {
+		return fmt.Errorf("open exoscale client failed: %w", err)
+	}
+	schemas, err := fetchSettingSchema(ctx, exo.Exoscale)
+	if err != nil {
+		return fmt.Errorf("cannot fetch settings schema: %w", err)
+	}
+	return webhook.ValidateSettings(schemas, changed)
+}
+
+func settingsOf(obj exoscalev1.PostgreSQLParameters) []webhook.Setting {
+	path := field.NewPath("spec", "forProvider")
+	return []webhook.Setting{
+		{Schema: "pg", Path: path.Child("pgSettings"), Value: obj.PGSettings},
+		{Schema: "pgbouncer", Path: path.Child("pgbouncerSettings"), Value: obj.PgBouncerSettings},
+		{Schema: "pglookout", Path: path.Child("pglookoutSettings"), Value: obj.PGLookoutSettings},
+		{Schema: "timescaledb", Path: path.Child("timescaledbSettings"), Value: obj.TimescaleDBSettings},
+	}
+}
+
+func validateRecovery(obj exoscalev1.PostgreSQLParameters) error {
+	return webhook.ValidateRecovery(toRecovery(obj))
+}
+
+func validateMigration(obj exoscalev1.PostgreSQLParameters) error {
+	return webhook.ValidateMigration(obj.Migration)
+}
+
+func toRecovery(obj exoscalev1.PostgreSQLParameters) webhook.Recovery {
+	return webhook.Recovery{
+		ForkFrom:           obj.ForkFrom,
+		ForkFromReferenced: obj.ForkFromRef != nil || obj.ForkFromSelector != nil,
+		BackupName:         obj.RecoveryBackupName,
+		TargetTime:         obj.RecoveryTargetTime,
+		ReadReplicaOf:      obj.ReadReplicaOf,
+	}
 }
 
 func validateImmutable(oldInst, newInst exoscalev1.PostgreSQL) error {
@@ -136,7 +244,7 @@ func validateImmutable(oldInst, newInst exoscalev1.PostgreSQL) error {
 	if err != nil {
 		return err
 	}
-	return compareVersion(oldInst, newInst)
+	return webhook.ValidateRecoveryImmutable(toRecovery(oldInst.Spec.ForProvider), toRecovery(newInst.Spec.ForProvider))
 }
 
 func compareZone(oldParams, newParams exoscalev1.PostgreSQLParameters) error {
@@ -146,7 +254,9 @@ func compareZone(oldParams, newParams exoscalev1.PostgreSQLParameters) error {
 	return nil
 }
 
-func compareVersion(oldInst, newInst exoscalev1.PostgreSQL) error {
+// validateUpgrade only allows changing the major version forward to an available version.
+// Changes within the same major version follow the rules of webhook.ValidateUpdateVersion.
+func (v *Validator) validateUpgrade(ctx context.Context, oldInst, newInst exoscalev1.PostgreSQL) error {
 	if oldInst.Spec.ForProvider.Version == newInst.Spec.ForProvider.Version {
 		return nil
 	}
@@ -154,5 +264,30 @@ func compareVersion(oldInst, newInst exoscalev1.PostgreSQL) error {
 		// Fall back to reported version if no version was set before
 		oldInst.Spec.ForProvider.Version = oldInst.Status.AtProvider.Version
 	}
-	return webhook.ValidateUpdateVersion(oldInst.Status.AtProvider.Version, oldInst.Spec.ForProvider.Version, newInst.Spec.ForProvider.Version)
+	if oldInst.GetCondition(exoscalev1.TypeUpgrading).Reason == exoscalev1.ReasonUpgradeInProgress {
+		return fmt.Errorf("version cannot be changed while an upgrade is in progress")
+	}
+	observed, oldDesired, newDesired := oldInst.Status.AtProvider.Version, oldInst.Spec.ForProvider.Version, newInst.Spec.ForProvider.Version
+
+	err := webhook.ValidateMajorUpgrade(observed, oldDesired, newDesired)
+	if err != nil {
+		return err
+	}
+	current := observed
+	if current == "" {
+		current = oldDesired
+	}
+	upgrade, err := mapper.IsMajorUpgrade(newDesired, current)
+	if err != nil {
+		return fmt.Errorf("cannot compare version: %w", err)
+	}
+	if !upgrade {
+		return webhook.ValidateUpdateVersion(observed, oldDesired, newDesired)
+	}
+
+	availableVersions, err := v.getAvailableVersions(ctx, &newInst)
+	if err != nil {
+		return err
+	}
+	return v.validateVersion(ctx, &newInst, availableVersions)
 }
@@ -0,0 +1,7 @@
+package postgresqldatabasecontroller
+
+import "context"
+
+func (p *pipeline) Disconnect(ctx context.Context) error {
+	return nil
+}
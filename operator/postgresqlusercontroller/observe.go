@@ -0,0 +1,121 @@
+package postgresqlusercontroller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/pipelineutil"
+)
+
+// Observe implements managed.ExternalClient.
+func (p *pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Observing resource")
+
+	user := mg.(*exoscalev1.PostgreSQLUser)
+
+	pg, err := p.exo.GetDBAASServicePG(ctx, user.Spec.ForProvider.InstanceName)
+	if err != nil {
+		if errors.Is(err, exoscalesdk.ErrNotFound) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, fmt.Errorf("cannot observe PostgreSQL instance: %w", err)
+	}
+
+	observed := findUser(pg.Users, user.GetUsername())
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	user.Status.AtProvider = mapObservation(observed)
+
+	secret, err := p.exo.RevealDBAASPostgresUserPassword(ctx, string(pg.Name), user.GetUsername())
+	if err != nil {
+		return managed.ExternalObservation{}, fmt.Errorf("cannot reveal password for PostgreSQL user: %w", err)
+	}
+
+	desiredPassword, err := pipelineutil.FetchPassword(ctx, p.kube, user.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	caCert, err := p.exo.GetDBAASCACertificate(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot retrieve CA certificate")
+	}
+
+	connDetails, err := connectionDetails(pg, user.GetUsername(), secret.Password, caCert.Certificate)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot read connection details")
+	}
+
+	user.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  isUpToDate(user.Spec.ForProvider, user.Status.AtProvider, desiredPassword, secret.Password),
+		ConnectionDetails: connDetails,
+	}, nil
+}
+
+func findUser(users []exoscalesdk.DBAASServicePGUsers, username string) *exoscalesdk.DBAASServicePGUsers {
+	for _, u := range users {
+		if u.Username == username {
+			return &u
+		}
+	}
+	return nil
+}
+
+// mapObservation fills the status fields from the given user.
+func mapObservation(user *exoscalesdk.DBAASServicePGUsers) exoscalev1.PostgreSQLUserObservation {
+	return exoscalev1.PostgreSQLUserObservation{
+		Username:         user.Username,
+		Type:             user.Type,
+		AllowReplication: ptr.Deref(user.AllowReplication, false),
+	}
+}
+
+// isUpToDate returns true if the observed user matches the desired spec.
+// The password is only compared if a password is referenced in the spec.
+func isUpToDate(spec exoscalev1.PostgreSQLUserParameters, observed exoscalev1.PostgreSQLUserObservation, desiredPassword, observedPassword string) bool {
+	if spec.AllowReplication != observed.AllowReplication {
+		return false
+	}
+	return desiredPassword == "" || desiredPassword == observedPassword
+}
+
+// connectionDetails returns the connection details of the instance with the credentials of the given user.
+func connectionDetails(in *exoscalesdk.DBAASServicePG, username, password, ca string) (managed.ConnectionDetails, error) {
+	uri := in.URI
+	// uri may be absent
+	if uri == "" {
+		if in.ConnectionInfo == nil || len(in.ConnectionInfo.URI) == 0 {
+			return map[string][]byte{}, nil
+		}
+		uri = in.ConnectionInfo.URI[0]
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse connection URL: %w", err)
+	}
+	parsed.User = url.UserPassword(username, password)
+	return map[string][]byte{
+		"POSTGRESQL_USER":     []byte(username),
+		"POSTGRESQL_PASSWORD": []byte(password),
+		"POSTGRESQL_URL":      []byte(parsed.String()),
+		"POSTGRESQL_DB":       []byte(strings.TrimPrefix(parsed.Path, "/")),
+		"POSTGRESQL_HOST":     []byte(parsed.Hostname()),
+		"POSTGRESQL_PORT":     []byte(parsed.Port()),
+		"ca.crt":              []byte(ca),
+	}, nil
+}
@@ -0,0 +1,47 @@
+package postgresqlusercontroller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/pipelineutil"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+)
+
+// Update implements managed.ExternalClient.
+// It updates the replication permission and resets the password to the one referenced in the spec.
+func (p *pipeline) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	log := controllerruntime.LoggerFrom(ctx)
+	log.V(1).Info("Updating resource")
+
+	user := mg.(*exoscalev1.PostgreSQLUser)
+	spec := user.Spec.ForProvider
+
+	if spec.AllowReplication != user.Status.AtProvider.AllowReplication {
+		_, err := p.exo.UpdateDBAASPostgresAllowReplication(ctx, spec.InstanceName, user.GetUsername(), exoscalesdk.UpdateDBAASPostgresAllowReplicationRequest{
+			AllowReplication: &spec.AllowReplication,
+		})
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update replication permission")
+		}
+	}
+
+	password, err := pipelineutil.FetchPassword(ctx, p.kube, user.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if password != "" {
+		resp, err := p.exo.ResetDBAASPostgresUserPassword(ctx, spec.InstanceName, user.GetUsername(), exoscalesdk.ResetDBAASPostgresUserPasswordRequest{
+			Password: exoscalesdk.DBAASUserPassword(password),
+		})
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, "cannot reset password")
+		}
+		log.V(1).Info("Response", "message", resp.Message)
+	}
+	return managed.ExternalUpdate{}, nil
+}
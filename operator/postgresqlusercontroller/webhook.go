@@ -0,0 +1,72 @@
+package postgresqlusercontroller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Validator validates admission requests.
+type Validator struct {
+	log logr.Logger
+}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	user, ok := obj.(*exoscalev1.PostgreSQLUser)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for postgresql user webhook", obj)
+	}
+	v.log.V(1).Info("Validate create", "name", user.Name)
+
+	return nil, validateSpec(user.Spec.ForProvider)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	newUser, ok := newObj.(*exoscalev1.PostgreSQLUser)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for postgresql user webhook", newObj)
+	}
+	oldUser, ok := oldObj.(*exoscalev1.PostgreSQLUser)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for postgresql user webhook", oldObj)
+	}
+	v.log.V(1).Info("Validate update", "name", newUser.Name)
+
+	err := validateSpec(newUser.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	return nil, validateImmutable(oldUser.Spec.ForProvider, newUser.Spec.ForProvider)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	v.log.V(1).Info("Validate delete (noop)")
+	return nil, nil
+}
+
+func validateSpec(params exoscalev1.PostgreSQLUserParameters) error {
+	if params.InstanceName == "" && params.InstanceRef == nil && params.InstanceSelector == nil {
+		return fmt.Errorf("one of instanceName, instanceRef or instanceSelector is required")
+	}
+	if ref := params.PasswordSecretRef; ref != nil && (ref.Name == "" || ref.Namespace == "" || ref.Key == "") {
+		return fmt.Errorf("passwordSecretRef requires name, namespace and key")
+	}
+	return nil
+}
+
+func validateImmutable(oldParams, newParams exoscalev1.PostgreSQLUserParameters) error {
+	if oldParams.Zone != newParams.Zone {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.Zone, newParams.Zone)
+	}
+	if oldParams.InstanceName != "" && oldParams.InstanceName != newParams.InstanceName {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldParams.InstanceName, newParams.InstanceName)
+	}
+	return nil
+}
@@ -6,13 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 
 	exoscalesdk "github.com/exoscale/egoscale/v3"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/externalendpoint"
 	"github.com/vshn/provider-exoscale/operator/mapper"
+	"github.com/vshn/provider-exoscale/operator/notification"
+	"github.com/vshn/provider-exoscale/operator/trigger"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -36,10 +40,13 @@ func (p pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	log.V(1).Info("retrieved instance", "state", redis.State)
 
+	previousTriggers := redisInstance.Status.AtProvider.Triggers
+	previousNotifications := redisInstance.Status.AtProvider.Notifications
 	redisInstance.Status.AtProvider, err = mapObservation(redis)
 	if err != nil {
 		log.Error(err, "unable to fully map observation, ignoring.")
 	}
+	notification.RecordWarnings(p.recorder, redisInstance, previousNotifications, redisInstance.Status.AtProvider.Notifications)
 
 	var state exoscalesdk.EnumServiceState
 	if redis.State != "" {
@@ -57,6 +64,7 @@ func (p pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.Ext
 	default:
 		log.V(2).Info("ignoring unknown instance state", "state", state)
 	}
+	mapper.SetBackupCondition(&redisInstance.Status.ConditionedStatus, redisInstance.Status.AtProvider.LastBackupTime, redis.CreatedAT, redisInstance.Spec.ForProvider.MaxBackupAge, time.Now())
 
 	rp, err := mapParameters(redis, redisInstance.Spec.ForProvider.Zone)
 	if err != nil {
@@ -67,15 +75,27 @@ func (p pipeline) Observe(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalObservation{}, fmt.Errorf("unable to parse connection details: %w", err)
 	}
 
-	currentParams, err := setSettingsDefaults(ctx, *p.exo, &redisInstance.Spec.ForProvider)
+	currentParams := &redisInstance.Spec.ForProvider
+	if currentParams.SettingsPolicy != exoscalev1.SettingsPolicyManagedKeysOnly {
+		// Unset settings are expected to have their default value.
+		currentParams, err = setSettingsDefaults(ctx, *p.exo, &redisInstance.Spec.ForProvider)
+		if err != nil {
+			log.Error(err, "unable to set redis settings schema")
+			currentParams = &redisInstance.Spec.ForProvider
+		}
+	}
+
+	endpoints, endpointsUpToDate, err := externalendpoint.Observe(ctx, p.kube, p.exo, &redisInstance.Status.ConditionedStatus, redisInstance.GetInstanceName(), redisInstance.Spec.ForProvider.ExternalEndpoints)
 	if err != nil {
-		log.Error(err, "unable to set redis settings schema")
-		currentParams = &redisInstance.Spec.ForProvider
+		return managed.ExternalObservation{}, err
 	}
+	redisInstance.Status.AtProvider.ExternalEndpoints = endpoints
+
+	redisInstance.Status.AtProvider.Triggers = previousTriggers
 
 	observation := managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        isUpToDate(currentParams, rp, log),
+		ResourceUpToDate:        isUpToDate(currentParams, rp, log) && endpointsUpToDate && trigger.IsUpToDate(redisInstance, previousTriggers),
 		ResourceLateInitialized: false,
 		ConnectionDetails:       cd,
 	}
@@ -89,11 +109,11 @@ func isUpToDate(current, external *exoscalev1.RedisParameters, log logr.Logger)
 	}
 	extIPFilter := []string(external.IPFilter)
 	checks := map[string]bool{
-		"IPFilter":              mapper.IsSameStringSet(current.IPFilter, &extIPFilter),
+		"IPFilter":              mapper.IsSameIPFilter(current.IPFilter, &extIPFilter),
 		"Maintenance":           current.Maintenance.Equals(external.Maintenance),
 		"Size":                  current.Size.Equals(external.Size),
 		"TerminationProtection": current.TerminationProtection == external.TerminationProtection,
-		"RedisSettings":         mapper.CompareSettings(current.RedisSettings, external.RedisSettings),
+		"RedisSettings":         mapper.CompareSettingsWithPolicy(current.SettingsPolicy, current.RedisSettings, external.RedisSettings),
 	}
 	ok := true
 	for _, v := range checks {
@@ -143,6 +163,7 @@ func mapObservation(instance *exoscalesdk.DBAASServiceRedis) (exoscalev1.RedisOb
 		Version:    instance.Version,
 		NodeStates: mapper.ToNodeStates(&instance.NodeStates),
 	}
+	observation.Backups, observation.LastBackupTime = mapper.ToBackups(instance.Backups)
 
 	observation.RedisSettings = settings
 
@@ -7,6 +7,8 @@ import (
 
 	exoscalesdk "github.com/exoscale/egoscale/v3"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/externalendpoint"
+	"github.com/vshn/provider-exoscale/operator/trigger"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -19,6 +21,10 @@ func (p pipeline) Update(ctx context.Context, mg resource.Managed) (managed.Exte
 
 	redisInstance := mg.(*exoscalev1.Redis)
 
+	redisInstance.Status.AtProvider.Triggers = trigger.Handle(ctx, p.recorder, redisInstance, redisInstance.GetInstanceName(), trigger.Actions{
+		trigger.MaintenanceAnnotation: p.exo.StartDBAASRedisMaintenance,
+	}, redisInstance.Status.AtProvider.Triggers)
+
 	spec := redisInstance.Spec.ForProvider
 	ipFilter := []string(spec.IPFilter)
 	settings := exoscalesdk.JSONSchemaRedis{}
@@ -44,5 +50,9 @@ func (p pipeline) Update(ctx context.Context, mg resource.Managed) (managed.Exte
 		return managed.ExternalUpdate{}, fmt.Errorf("unable to create instance: %w", err)
 	}
 	log.V(1).Info("response", "message", string(resp.Message))
+	err = externalendpoint.Update(ctx, p.kube, p.exo, redisInstance.GetInstanceName(), spec.ExternalEndpoints, redisInstance.Status.AtProvider.ExternalEndpoints)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 	return managed.ExternalUpdate{}, nil
 }
@@ -5,50 +5,148 @@ import (
 	"fmt"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	exoscalesdk "github.com/exoscale/egoscale/v3"
 	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	"github.com/vshn/provider-exoscale/operator/common"
 	"github.com/vshn/provider-exoscale/operator/mapper"
+	"github.com/vshn/provider-exoscale/operator/pipelineutil"
 	"github.com/vshn/provider-exoscale/operator/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+const serviceType = "redis"
+
 // Validator validates admission requests.
 type Validator struct {
-	log logr.Logger
+	log  logr.Logger
+	kube client.Client
 }
 
 // ValidateCreate implements admission.CustomValidator.
-func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	instance := obj.(*exoscalev1.Redis)
 	v.log.V(1).Info("validate create")
 
-	return nil, v.validateSpec(instance)
+	dbaasType, err := v.getServiceType(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+	err = webhook.ValidatePlan(instance.Spec.ForProvider.Size.Plan, instance.Spec.ForProvider.Zone.String(), dbaasType.Plans)
+	if err != nil {
+		return nil, err
+	}
+
+	err = v.validateIpFilter(instance.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	err = v.validateSpec(instance)
+	if err != nil {
+		return nil, err
+	}
+	return nil, v.validateSettingsSchema(ctx, nil, instance)
+}
+
+func (v *Validator) getServiceType(ctx context.Context, instance *exoscalev1.Redis) (*exoscalesdk.DBAASServiceType, error) {
+	v.log.V(1).Info("get redis service type")
+	exo, err := pipelineutil.OpenExoscaleClient(ctx, v.kube, instance.GetProviderConfigName(), exoscalesdk.ClientOptWithEndpoint(common.ZoneTranslation[instance.Spec.ForProvider.Zone]))
+	if err != nil {
+		return nil, fmt.Errorf("open exoscale client failed: %w", err)
+	}
+
+	resp, err := exo.Exoscale.GetDBAASServiceType(ctx, serviceType)
+	if err != nil {
+		return nil, fmt.Errorf("get DBaaS service type failed: %w", err)
+	}
+
+	v.log.V(1).Info("DBaaS service type", "name", string(resp.Name), "description", string(resp.Description))
+	return resp, nil
+}
+
+// validatePlanChange validates a changed plan and warns about downgrades.
+func (v *Validator) validatePlanChange(ctx context.Context, oldInst, newInst *exoscalev1.Redis) (admission.Warnings, error) {
+	oldPlan, newPlan := oldInst.Spec.ForProvider.Size.Plan, newInst.Spec.ForProvider.Size.Plan
+	if oldPlan == newPlan {
+		return nil, nil
+	}
+	dbaasType, err := v.getServiceType(ctx, newInst)
+	if err != nil {
+		return nil, err
+	}
+	return webhook.ValidatePlanChange(oldPlan, newPlan, newInst.Spec.ForProvider.Zone.String(), dbaasType.Plans)
 }
 
 // ValidateUpdate implements admission.CustomValidator.
-func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	newInstance := newObj.(*exoscalev1.Redis)
 	oldInstance := oldObj.(*exoscalev1.Redis)
 	v.log.V(1).Info("validate update")
 
-	err := v.validateSpec(newInstance)
+	err := webhook.ValidateIPFilterUpdate(oldInstance.Spec.ForProvider.IPFilter, newInstance.Spec.ForProvider.IPFilter)
 	if err != nil {
 		return nil, err
 	}
-	return nil, v.compare(oldInstance, newInstance)
+	err = v.validateSpec(newInstance)
+	if err != nil {
+		return nil, err
+	}
+	err = v.compare(oldInstance, newInstance)
+	if err != nil {
+		return nil, err
+	}
+	err = v.validateSettingsSchema(ctx, oldInstance, newInstance)
+	if err != nil {
+		return nil, err
+	}
+	return v.validatePlanChange(ctx, oldInstance, newInstance)
 }
 
 // ValidateDelete implements admission.CustomValidator.
-func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
-	v.log.V(1).Info("validate delete (noop)")
-	return nil, nil
+func (v *Validator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	instance, ok := obj.(*exoscalev1.Redis)
+	if !ok {
+		return nil, fmt.Errorf("invalid managed resource type %T for redis webhook", obj)
+	}
+	v.log.V(1).Info("validate delete")
+	return nil, webhook.ValidateTerminationProtection(instance, instance.Spec.ForProvider.TerminationProtection, false)
+}
+
+// validateSettingsSchema validates new or changed settings against the settings schema of the service.
+// oldInst is nil on creation.
+func (v *Validator) validateSettingsSchema(ctx context.Context, oldInst, newInst *exoscalev1.Redis) error {
+	var previous []webhook.Setting
+	if oldInst != nil {
+		previous = settingsOf(oldInst.Spec.ForProvider)
+	}
+	changed := webhook.ChangedSettings(previous, settingsOf(newInst.Spec.ForProvider))
+	if len(changed) == 0 {
+		return nil
+	}
+	exo, err := pipelineutil.OpenExoscaleClient(ctx, v.kube, newInst.GetProviderConfigName(), exoscalesdk.ClientOptWithEndpoint(common.ZoneTranslation[newInst.Spec.ForProvider.Zone]))
+	if err != nil {
+		return fmt.Errorf("open exoscale client failed: %w", err)
+	}
+	schemas, err := fetchSettingSchema(ctx, exo.Exoscale)
+	if err != nil {
+		return fmt.Errorf("cannot fetch settings schema: %w", err)
+	}
+	return webhook.ValidateSettings(schemas, changed)
+}
+
+func settingsOf(obj exoscalev1.RedisParameters) []webhook.Setting {
+	path := field.NewPath("spec", "forProvider")
+	return []webhook.Setting{
+		{Schema: "redis", Path: path.Child("redisSettings"), Value: obj.RedisSettings},
+	}
 }
 
 func (v *Validator) validateSpec(obj *exoscalev1.Redis) error {
 	for _, validatorFn := range []func(exoscalev1.RedisParameters) error{
-		v.validateIpFilter,
 		v.validateMaintenanceSchedule,
 		v.validateRedisSettings,
 	} {
@@ -60,10 +158,7 @@ func (v *Validator) validateSpec(obj *exoscalev1.Redis) error {
 }
 
 func (v *Validator) validateIpFilter(obj exoscalev1.RedisParameters) error {
-	if len(obj.IPFilter) == 0 {
-		return fmt.Errorf("IP filter cannot be empty")
-	}
-	return nil
+	return webhook.ValidateIPFilter(obj.IPFilter)
 }
 
 func (v *Validator) validateMaintenanceSchedule(obj exoscalev1.RedisParameters) error {
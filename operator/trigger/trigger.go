@@ -0,0 +1,105 @@
+// Package trigger runs on-demand operations on DBaaS services requested by annotation.
+package trigger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MaintenanceAnnotation starts the pending maintenance of a service whenever its value changes.
+	MaintenanceAnnotation = "exoscale.crossplane.io/trigger-maintenance"
+	// BackupAnnotation takes a backup of a service whenever its value changes.
+	BackupAnnotation = "exoscale.crossplane.io/trigger-backup"
+)
+
+// Annotations are the annotations that trigger operations.
+var Annotations = []string{MaintenanceAnnotation, BackupAnnotation}
+
+// Action triggers an operation on the given service.
+type Action func(ctx context.Context, serviceName string) (*exoscalesdk.Operation, error)
+
+// Actions maps annotations to the operations they trigger.
+// Annotations without action are reported as not supported.
+type Actions map[string]Action
+
+// IsUpToDate returns true if the current values of all annotations have been handled.
+func IsUpToDate(mg resource.Managed, observed []exoscalev1.TriggerObservation) bool {
+	for _, annotation := range Annotations {
+		if isPending(mg, annotation, observed) {
+			return false
+		}
+	}
+	return true
+}
+
+// Handle triggers the operations of the annotations whose current value hasn't been handled yet
+// and returns the updated observations.
+// The outcome is recorded as event. Failed operations aren't retried until the value of the annotation changes.
+func Handle(ctx context.Context, recorder event.Recorder, mg resource.Managed, serviceName string, actions Actions, observed []exoscalev1.TriggerObservation) []exoscalev1.TriggerObservation {
+	for _, annotation := range Annotations {
+		if !isPending(mg, annotation, observed) {
+			continue
+		}
+		outcome := exoscalev1.TriggerObservation{
+			Annotation: annotation,
+			Value:      mg.GetAnnotations()[annotation],
+			Time:       metav1.Now(),
+		}
+		action, ok := actions[annotation]
+		if !ok {
+			outcome.Message = "operation is not supported for this service"
+		} else if _, err := action(ctx, serviceName); err != nil {
+			outcome.Message = fmt.Sprintf("cannot trigger operation: %s", err)
+		} else {
+			outcome.Succeeded = true
+			outcome.Message = "operation triggered"
+		}
+		recorder.Event(mg, toEvent(outcome))
+		observed = setObservation(observed, outcome)
+	}
+	return observed
+}
+
+// isPending returns true if the given annotation is set to a value which hasn't been handled yet.
+func isPending(mg resource.Managed, annotation string, observed []exoscalev1.TriggerObservation) bool {
+	value, ok := mg.GetAnnotations()[annotation]
+	if !ok || value == "" {
+		return false
+	}
+	for _, o := range observed {
+		if o.Annotation == annotation {
+			return o.Value != value
+		}
+	}
+	return true
+}
+
+func setObservation(observed []exoscalev1.TriggerObservation, outcome exoscalev1.TriggerObservation) []exoscalev1.TriggerObservation {
+	for i, o := range observed {
+		if o.Annotation == outcome.Annotation {
+			observed[i] = outcome
+			return observed
+		}
+	}
+	return append(observed, outcome)
+}
+
+func toEvent(outcome exoscalev1.TriggerObservation) event.Event {
+	e := event.Event{
+		Type:    event.TypeNormal,
+		Reason:  "Triggered",
+		Message: fmt.Sprintf("%s=%s: %s", outcome.Annotation, outcome.Value, outcome.Message),
+	}
+	if !outcome.Succeeded {
+		e.Type = event.TypeWarning
+		e.Reason = "TriggerFailed"
+	}
+	return e
+}
@@ -0,0 +1,142 @@
+package trigger
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type fakeRecorder struct {
+	events []event.Event
+}
+
+func (r *fakeRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *fakeRecorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func TestIsUpToDate(t *testing.T) {
+	tests := map[string]struct {
+		givenAnnotations map[string]string
+		givenObserved    []exoscalev1.TriggerObservation
+		expected         bool
+	}{
+		"NoAnnotations": {
+			expected: true,
+		},
+		"EmptyValue": {
+			givenAnnotations: map[string]string{MaintenanceAnnotation: ""},
+			expected:         true,
+		},
+		"NewAnnotation": {
+			givenAnnotations: map[string]string{MaintenanceAnnotation: "1"},
+			expected:         false,
+		},
+		"HandledValue": {
+			givenAnnotations: map[string]string{MaintenanceAnnotation: "1"},
+			givenObserved:    []exoscalev1.TriggerObservation{{Annotation: MaintenanceAnnotation, Value: "1"}},
+			expected:         true,
+		},
+		"ChangedValue": {
+			givenAnnotations: map[string]string{MaintenanceAnnotation: "2"},
+			givenObserved:    []exoscalev1.TriggerObservation{{Annotation: MaintenanceAnnotation, Value: "1"}},
+			expected:         false,
+		},
+		"OtherAnnotationHandled": {
+			givenAnnotations: map[string]string{BackupAnnotation: "1"},
+			givenObserved:    []exoscalev1.TriggerObservation{{Annotation: MaintenanceAnnotation, Value: "1"}},
+			expected:         false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mg := &exoscalev1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Annotations: tc.givenAnnotations}}
+			assert.Equal(t, tc.expected, IsUpToDate(mg, tc.givenObserved))
+		})
+	}
+}
+
+func TestHandle(t *testing.T) {
+	calls := 0
+	actions := Actions{
+		MaintenanceAnnotation: func(_ context.Context, serviceName string) (*exoscalesdk.Operation, error) {
+			calls++
+			if serviceName == "broken" {
+				return nil, errors.New("service unavailable")
+			}
+			return &exoscalesdk.Operation{}, nil
+		},
+	}
+	tests := map[string]struct {
+		givenServiceName string
+		givenAnnotations map[string]string
+		givenObserved    []exoscalev1.TriggerObservation
+		expectedCalls    int
+		expectedOutcome  map[string]bool
+		expectedEvents   []event.Type
+	}{
+		"Nothing": {
+			givenServiceName: "service",
+			expectedOutcome:  map[string]bool{},
+		},
+		"Maintenance": {
+			givenServiceName: "service",
+			givenAnnotations: map[string]string{MaintenanceAnnotation: "1"},
+			expectedCalls:    1,
+			expectedOutcome:  map[string]bool{MaintenanceAnnotation: true},
+			expectedEvents:   []event.Type{event.TypeNormal},
+		},
+		"AlreadyHandled": {
+			givenServiceName: "service",
+			givenAnnotations: map[string]string{MaintenanceAnnotation: "1"},
+			givenObserved:    []exoscalev1.TriggerObservation{{Annotation: MaintenanceAnnotation, Value: "1", Succeeded: true}},
+			expectedOutcome:  map[string]bool{MaintenanceAnnotation: true},
+		},
+		"Failure": {
+			givenServiceName: "broken",
+			givenAnnotations: map[string]string{MaintenanceAnnotation: "1"},
+			expectedCalls:    1,
+			expectedOutcome:  map[string]bool{MaintenanceAnnotation: false},
+			expectedEvents:   []event.Type{event.TypeWarning},
+		},
+		"Unsupported": {
+			givenServiceName: "service",
+			givenAnnotations: map[string]string{BackupAnnotation: "1"},
+			expectedOutcome:  map[string]bool{BackupAnnotation: false},
+			expectedEvents:   []event.Type{event.TypeWarning},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls = 0
+			recorder := &fakeRecorder{}
+			mg := &exoscalev1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Annotations: tc.givenAnnotations}}
+
+			observed := Handle(context.Background(), recorder, mg, tc.givenServiceName, actions, tc.givenObserved)
+
+			assert.Equal(t, tc.expectedCalls, calls)
+			outcome := map[string]bool{}
+			for _, o := range observed {
+				assert.Equal(t, tc.givenAnnotations[o.Annotation], o.Value)
+				outcome[o.Annotation] = o.Succeeded
+			}
+			assert.Equal(t, tc.expectedOutcome, outcome)
+			var types []event.Type
+			for _, e := range recorder.events {
+				types = append(types, e.Type)
+			}
+			assert.Equal(t, tc.expectedEvents, types)
+			assert.True(t, IsUpToDate(mg, observed))
+		})
+	}
+}
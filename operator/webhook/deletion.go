@@ -0,0 +1,23 @@
+package webhook
+
+import (
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AllowDeletionAnnotation allows deleting a resource despite its termination protection if set to "true".
+const AllowDeletionAnnotation = "exoscale.crossplane.io/allow-deletion"
+
+// ValidateTerminationProtection rejects the deletion of a resource whose termination protection is enabled in the desired or observed state.
+// Resources that are orphaned or that have the AllowDeletionAnnotation can always be deleted.
+func ValidateTerminationProtection(mg resource.Managed, desired, observed bool) error {
+	if !desired && !observed {
+		return nil
+	}
+	if mg.GetDeletionPolicy() == xpv1.DeletionOrphan || mg.GetAnnotations()[AllowDeletionAnnotation] == "true" {
+		return nil
+	}
+	return fmt.Errorf("termination protection is enabled, disable it or set the annotation %s=true to delete the resource", AllowDeletionAnnotation)
+}
@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/stretchr/testify/assert"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+func TestValidateTerminationProtection(t *testing.T) {
+	tests := map[string]struct {
+		givenDesired     bool
+		givenObserved    bool
+		givenAnnotations map[string]string
+		givenPolicy      xpv1.DeletionPolicy
+		expectedErr      bool
+	}{
+		"NotProtected": {},
+		"ProtectedInSpec": {
+			givenDesired: true,
+			expectedErr:  true,
+		},
+		"ProtectedObserved": {
+			givenObserved: true,
+			expectedErr:   true,
+		},
+		"Allowed": {
+			givenDesired:     true,
+			givenObserved:    true,
+			givenAnnotations: map[string]string{AllowDeletionAnnotation: "true"},
+		},
+		"AllowedFalse": {
+			givenDesired:     true,
+			givenAnnotations: map[string]string{AllowDeletionAnnotation: "false"},
+			expectedErr:      true,
+		},
+		"Orphaned": {
+			givenDesired: true,
+			givenPolicy:  xpv1.DeletionOrphan,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mg := &exoscalev1.PostgreSQL{}
+			mg.SetAnnotations(tc.givenAnnotations)
+			mg.SetDeletionPolicy(tc.givenPolicy)
+
+			err := ValidateTerminationProtection(mg, tc.givenDesired, tc.givenObserved)
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	"fmt"
+
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+// ValidateMigration validates the migration from an existing database server, if given.
+func ValidateMigration(m *exoscalev1.MigrationSpec) error {
+	if m == nil {
+		return nil
+	}
+	if m.Host == "" {
+		return fmt.Errorf("migration.host is required")
+	}
+	if m.Port < 1 || m.Port > 65535 {
+		return fmt.Errorf("migration.port must be between 1 and 65535: %d", m.Port)
+	}
+	if ref := m.CredentialsSecretRef; ref != nil && (ref.Name == "" || ref.Namespace == "") {
+		return fmt.Errorf("migration.credentialsSecretRef requires name and namespace")
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/stretchr/testify/assert"
+	exoscalev1 "github.com/vshn/provider-exoscale/apis/exoscale/v1"
+)
+
+func TestValidateMigration(t *testing.T) {
+	tests := map[string]struct {
+		given         *exoscalev1.MigrationSpec
+		expectedError string
+	}{
+		"NoMigration": {given: nil},
+		"Valid": {
+			given: &exoscalev1.MigrationSpec{
+				Host:                 "db.example.com",
+				Port:                 5432,
+				CredentialsSecretRef: &xpv1.SecretReference{Name: "creds", Namespace: "default"},
+			},
+		},
+		"MissingHost": {
+			given:         &exoscalev1.MigrationSpec{Port: 5432},
+			expectedError: "migration.host is required",
+		},
+		"InvalidPort": {
+			given:         &exoscalev1.MigrationSpec{Host: "db.example.com"},
+			expectedError: "migration.port must be between 1 and 65535: 0",
+		},
+		"IncompleteSecretRef": {
+			given: &exoscalev1.MigrationSpec{
+				Host:                 "db.example.com",
+				Port:                 5432,
+				CredentialsSecretRef: &xpv1.SecretReference{Name: "creds"},
+			},
+			expectedError: "migration.credentialsSecretRef requires name and namespace",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateMigration(tc.given)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
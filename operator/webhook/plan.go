@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"fmt"
+	"strings"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ValidatePlan validates that the given plan is offered for the service type, is available in the given zone and is authorized for the organization.
+// The zone isn't checked if the plan doesn't list its zones.
+func ValidatePlan(plan, zone string, plans []exoscalesdk.DBAASPlan) error {
+	if plan == "" {
+		return fmt.Errorf("plan must be provided")
+	}
+	p := findPlan(plan, plans)
+	if p == nil {
+		return fmt.Errorf("plan %q not valid, allowed plans are %v", plan, planNames(plans))
+	}
+	if p.Authorized != nil && !*p.Authorized {
+		return fmt.Errorf("plan %q requires authorization, contact Exoscale support to enable it", plan)
+	}
+	if len(p.Zones) != 0 && !containsFold(p.Zones, zone) {
+		return fmt.Errorf("plan %q is not available in zone %q, available zones are %v", plan, zone, p.Zones)
+	}
+	return nil
+}
+
+// ValidatePlanChange validates the new plan and returns warnings if it is smaller than the old plan.
+func ValidatePlanChange(oldPlan, newPlan, zone string, plans []exoscalesdk.DBAASPlan) (admission.Warnings, error) {
+	err := ValidatePlan(newPlan, zone, plans)
+	if err != nil {
+		return nil, err
+	}
+	oldP, newP := findPlan(oldPlan, plans), findPlan(newPlan, plans)
+	if oldP == nil {
+		return nil, nil
+	}
+	var warnings admission.Warnings
+	if newP.DiskSpace < oldP.DiskSpace {
+		warnings = append(warnings, fmt.Sprintf("plan %q has less disk space than plan %q (%d < %d bytes), make sure the existing data fits", newPlan, oldPlan, newP.DiskSpace, oldP.DiskSpace))
+	}
+	if newP.NodeMemory < oldP.NodeMemory {
+		warnings = append(warnings, fmt.Sprintf("plan %q has less memory per node than plan %q (%d < %d bytes)", newPlan, oldPlan, newP.NodeMemory, oldP.NodeMemory))
+	}
+	if newP.NodeCount < oldP.NodeCount {
+		warnings = append(warnings, fmt.Sprintf("plan %q has fewer nodes than plan %q (%d < %d)", newPlan, oldPlan, newP.NodeCount, oldP.NodeCount))
+	}
+	return warnings, nil
+}
+
+func findPlan(name string, plans []exoscalesdk.DBAASPlan) *exoscalesdk.DBAASPlan {
+	for i := range plans {
+		if plans[i].Name == name {
+			return &plans[i]
+		}
+	}
+	return nil
+}
+
+func planNames(plans []exoscalesdk.DBAASPlan) []string {
+	names := make([]string, len(plans))
+	for i, p := range plans {
+		names[i] = p.Name
+	}
+	return names
+}
+
+func containsFold(s []string, e string) bool {
+	for _, a := range s {
+		if strings.EqualFold(a, e) {
+			return true
+		}
+	}
+	return false
+}
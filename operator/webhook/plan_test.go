@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"testing"
+
+	exoscalesdk "github.com/exoscale/egoscale/v3"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+)
+
+var plans = []exoscalesdk.DBAASPlan{
+	{Name: "hobbyist-2", Authorized: ptr.To(true), DiskSpace: 8 << 30, NodeMemory: 2 << 30, NodeCount: 1, Zones: []string{"ch-gva-2", "ch-dk-2"}},
+	{Name: "startup-4", Authorized: ptr.To(true), DiskSpace: 80 << 30, NodeMemory: 4 << 30, NodeCount: 1},
+	{Name: "business-4", Authorized: ptr.To(true), DiskSpace: 80 << 30, NodeMemory: 4 << 30, NodeCount: 2},
+	{Name: "premium-225", Authorized: ptr.To(false), DiskSpace: 8 << 40, NodeMemory: 225 << 30, NodeCount: 3},
+}
+
+func TestValidatePlan(t *testing.T) {
+	tests := map[string]struct {
+		givenPlan   string
+		givenZone   string
+		expectedErr string
+	}{
+		"Valid":        {givenPlan: "startup-4", givenZone: "de-fra-1"},
+		"ValidInZone":  {givenPlan: "hobbyist-2", givenZone: "CH-GVA-2"},
+		"Empty":        {givenZone: "ch-gva-2", expectedErr: "plan must be provided"},
+		"Unknown":      {givenPlan: "startup-5", givenZone: "ch-gva-2", expectedErr: `plan "startup-5" not valid, allowed plans are [hobbyist-2 startup-4 business-4 premium-225]`},
+		"Unauthorized": {givenPlan: "premium-225", givenZone: "ch-gva-2", expectedErr: `plan "premium-225" requires authorization, contact Exoscale support to enable it`},
+		"OtherZone":    {givenPlan: "hobbyist-2", givenZone: "de-fra-1", expectedErr: `plan "hobbyist-2" is not available in zone "de-fra-1", available zones are [ch-gva-2 ch-dk-2]`},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidatePlan(tc.givenPlan, tc.givenZone, plans)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePlanChange(t *testing.T) {
+	tests := map[string]struct {
+		givenOld         string
+		givenNew         string
+		expectedWarnings int
+		expectedErr      bool
+	}{
+		"Upgrade":        {givenOld: "hobbyist-2", givenNew: "startup-4"},
+		"SameSize":       {givenOld: "startup-4", givenNew: "business-4"},
+		"Downgrade":      {givenOld: "startup-4", givenNew: "hobbyist-2", expectedWarnings: 2},
+		"FewerNodes":     {givenOld: "business-4", givenNew: "startup-4", expectedWarnings: 1},
+		"UnknownOldPlan": {givenOld: "legacy-1", givenNew: "hobbyist-2"},
+		"UnknownNewPlan": {givenOld: "startup-4", givenNew: "legacy-1", expectedErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			warnings, err := ValidatePlanChange(tc.givenOld, tc.givenNew, "ch-gva-2", plans)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, warnings, tc.expectedWarnings)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Recovery contains the create-time parameters to fork, restore or replicate a DBaaS service.
+type Recovery struct {
+	// ForkFrom is the name of the service to fork from.
+	ForkFrom string
+	// ForkFromReferenced is true if the service to fork from is given by reference or selector.
+	ForkFromReferenced bool
+	// BackupName is the name of the backup to restore.
+	BackupName string
+	// TargetTime is the point in time to restore.
+	TargetTime *metav1.Time
+	// ReadReplicaOf is the name of the service to replicate.
+	ReadReplicaOf string
+}
+
+// ValidateRecovery validates that a backup or point in time is only given together with a service to fork from.
+func ValidateRecovery(r Recovery) error {
+	forked := r.ForkFrom != "" || r.ForkFromReferenced
+	if r.BackupName != "" && r.TargetTime != nil {
+		return fmt.Errorf("recoveryBackupName and recoveryTargetTime are mutually exclusive")
+	}
+	if (r.BackupName != "" || r.TargetTime != nil) && !forked {
+		return fmt.Errorf("recoveryBackupName and recoveryTargetTime require forkFrom")
+	}
+	if r.ReadReplicaOf != "" && forked {
+		return fmt.Errorf("readReplicaOf and forkFrom are mutually exclusive")
+	}
+	return nil
+}
+
+// ValidateRecoveryImmutable validates that the recovery parameters didn't change after creation.
+// The service to fork from may only be set afterwards if it has been given by reference.
+func ValidateRecoveryImmutable(oldR, newR Recovery) error {
+	if oldR.ForkFrom != newR.ForkFrom && (oldR.ForkFrom != "" || !oldR.ForkFromReferenced) {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldR.ForkFrom, newR.ForkFrom)
+	}
+	if oldR.BackupName != newR.BackupName {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldR.BackupName, newR.BackupName)
+	}
+	if !oldR.TargetTime.Equal(newR.TargetTime) {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", formatTime(oldR.TargetTime), formatTime(newR.TargetTime))
+	}
+	if oldR.ReadReplicaOf != newR.ReadReplicaOf {
+		return fmt.Errorf("field is immutable: %s (old), %s (changed)", oldR.ReadReplicaOf, newR.ReadReplicaOf)
+	}
+	return nil
+}
+
+func formatTime(t *metav1.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
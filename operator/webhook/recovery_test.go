@@ -0,0 +1,103 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateRecovery(t *testing.T) {
+	targetTime := &metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	tests := map[string]struct {
+		given         Recovery
+		expectedError string
+	}{
+		"Empty":          {given: Recovery{}},
+		"ForkOnly":       {given: Recovery{ForkFrom: "source"}},
+		"ForkFromBackup": {given: Recovery{ForkFrom: "source", BackupName: "backup"}},
+		"ForkFromTime":   {given: Recovery{ForkFrom: "source", TargetTime: targetTime}},
+		"ReferencedFork": {given: Recovery{ForkFromReferenced: true, TargetTime: targetTime}},
+		"BackupWithoutFork": {
+			given:         Recovery{BackupName: "backup"},
+			expectedError: "recoveryBackupName and recoveryTargetTime require forkFrom",
+		},
+		"TimeWithoutFork": {
+			given:         Recovery{TargetTime: targetTime},
+			expectedError: "recoveryBackupName and recoveryTargetTime require forkFrom",
+		},
+		"BackupAndTime": {
+			given:         Recovery{ForkFrom: "source", BackupName: "backup", TargetTime: targetTime},
+			expectedError: "recoveryBackupName and recoveryTargetTime are mutually exclusive",
+		},
+		"ReadReplica": {given: Recovery{ReadReplicaOf: "source"}},
+		"ReadReplicaAndFork": {
+			given:         Recovery{ForkFrom: "source", ReadReplicaOf: "source"},
+			expectedError: "readReplicaOf and forkFrom are mutually exclusive",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateRecovery(tc.given)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRecoveryImmutable(t *testing.T) {
+	targetTime := &metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	tests := map[string]struct {
+		givenOld      Recovery
+		givenNew      Recovery
+		expectedError string
+	}{
+		"Unchanged": {
+			givenOld: Recovery{ForkFrom: "source", TargetTime: targetTime},
+			givenNew: Recovery{ForkFrom: "source", TargetTime: &metav1.Time{Time: targetTime.Time}},
+		},
+		"ResolvedReference": {
+			givenOld: Recovery{ForkFromReferenced: true},
+			givenNew: Recovery{ForkFrom: "source", ForkFromReferenced: true},
+		},
+		"ForkAdded": {
+			givenOld:      Recovery{},
+			givenNew:      Recovery{ForkFrom: "source"},
+			expectedError: "field is immutable:  (old), source (changed)",
+		},
+		"ForkChanged": {
+			givenOld:      Recovery{ForkFrom: "source", ForkFromReferenced: true},
+			givenNew:      Recovery{ForkFrom: "other", ForkFromReferenced: true},
+			expectedError: "field is immutable: source (old), other (changed)",
+		},
+		"BackupChanged": {
+			givenOld:      Recovery{ForkFrom: "source", BackupName: "backup-1"},
+			givenNew:      Recovery{ForkFrom: "source", BackupName: "backup-2"},
+			expectedError: "field is immutable: backup-1 (old), backup-2 (changed)",
+		},
+		"ReadReplicaAdded": {
+			givenOld:      Recovery{},
+			givenNew:      Recovery{ReadReplicaOf: "source"},
+			expectedError: "field is immutable:  (old), source (changed)",
+		},
+		"TimeRemoved": {
+			givenOld:      Recovery{ForkFrom: "source", TargetTime: targetTime},
+			givenNew:      Recovery{ForkFrom: "source"},
+			expectedError: "field is immutable: 2024-01-01T00:00:00Z (old),  (changed)",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateRecoveryImmutable(tc.givenOld, tc.givenNew)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
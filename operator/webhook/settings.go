@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"bytes"
+
+	"github.com/vshn/provider-exoscale/internal/settings"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Setting is a group of settings that is validated against the schema with the given name.
+type Setting struct {
+	Schema string
+	Path   *field.Path
+	Value  runtime.RawExtension
+}
+
+// ChangedSettings returns the non-empty settings of current that differ from the settings with the same schema in previous.
+// previous is empty when a resource is created.
+func ChangedSettings(previous, current []Setting) []Setting {
+	old := make(map[string][]byte, len(previous))
+	for _, s := range previous {
+		old[s.Schema] = s.Value.Raw
+	}
+	var changed []Setting
+	for _, s := range current {
+		if len(s.Value.Raw) == 0 {
+			continue
+		}
+		if raw, ok := old[s.Schema]; ok && bytes.Equal(raw, s.Value.Raw) {
+			continue
+		}
+		changed = append(changed, s)
+	}
+	return changed
+}
+
+// ValidateSettings validates the given settings against their schemas.
+func ValidateSettings(schemas settings.Schemas, toValidate []Setting) error {
+	var errs []error
+	for _, s := range toValidate {
+		if err := schemas.Validate(s.Schema, s.Value, s.Path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestChangedSettings(t *testing.T) {
+	tests := map[string]struct {
+		previous []Setting
+		current  []Setting
+		expected []string
+	}{
+		"Create": {
+			current:  []Setting{{Schema: "pg", Value: raw(`{"jit":true}`)}, {Schema: "pgbouncer"}},
+			expected: []string{"pg"},
+		},
+		"Unchanged": {
+			previous: []Setting{{Schema: "pg", Value: raw(`{"jit":true}`)}},
+			current:  []Setting{{Schema: "pg", Value: raw(`{"jit":true}`)}},
+		},
+		"Changed": {
+			previous: []Setting{{Schema: "pg", Value: raw(`{"jit":true}`)}, {Schema: "pgbouncer", Value: raw(`{}`)}},
+			current:  []Setting{{Schema: "pg", Value: raw(`{"jit":false}`)}, {Schema: "pgbouncer", Value: raw(`{}`)}},
+			expected: []string{"pg"},
+		},
+		"Removed": {
+			previous: []Setting{{Schema: "pg", Value: raw(`{"jit":true}`)}},
+			current:  []Setting{{Schema: "pg"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var changed []string
+			for _, s := range ChangedSettings(tc.previous, tc.current) {
+				changed = append(changed, s.Schema)
+			}
+			assert.Equal(t, tc.expected, changed)
+		})
+	}
+}
+
+func raw(s string) runtime.RawExtension {
+	return runtime.RawExtension{Raw: []byte(s)}
+}
@@ -2,32 +2,98 @@ package webhook
 
 import (
 	"fmt"
+	"net/netip"
+	"slices"
+	"sort"
+	"strconv"
 
 	"github.com/hashicorp/go-version"
 	"github.com/vshn/provider-exoscale/operator/mapper"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+// ValidateRawExtension validates that the settings are an object of strings, booleans, numbers,
+// and nested objects and arrays thereof.
 func ValidateRawExtension(raw runtime.RawExtension) error {
 	m, err := mapper.ToMap(raw)
 	if err != nil {
 		return fmt.Errorf("mapper.ToMap(%q): %w", raw, err)
 	}
-	for k, v := range m {
-		switch v.(type) {
-		case string:
-		case int64:
-		case float64:
-		case bool:
-			continue
-		default:
-			return fmt.Errorf("validate: value of key %q is not a supported type (only strings, boolean and numbers): %v", k, v)
+	// Sort the keys for a stable error message.
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := validateSettingValue(strconv.Quote(k), m[k]); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+func validateSettingValue(key string, value interface{}) error {
+	switch v := value.(type) {
+	case string, int64, float64, bool:
+		return nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if err := validateSettingValue(fmt.Sprintf("%s.%s", key, strconv.Quote(k)), v[k]); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		for i, item := range v {
+			if err := validateSettingValue(fmt.Sprintf("%s[%d]", key, i), item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("validate: value of key %s is not a supported type (only strings, boolean, numbers, objects and arrays): %v", key, value)
+}
+
+// ValidateIPFilter validates that the IP filter isn't empty and that every entry is an IP address or CIDR range.
+// Entries that allow the same addresses are reported as duplicates.
+func ValidateIPFilter(filter []string) error {
+	path := field.NewPath("spec", "forProvider", "ipFilter")
+	if len(filter) == 0 {
+		return field.Required(path, "IP filter cannot be empty")
+	}
+	var errs field.ErrorList
+	seen := make(map[netip.Prefix]struct{}, len(filter))
+	for i, entry := range filter {
+		prefix, err := mapper.ParseIPFilterEntry(entry)
+		if err != nil {
+			errs = append(errs, field.Invalid(path.Index(i), entry, "must be an IP address or CIDR range"))
+			continue
+		}
+		if _, ok := seen[prefix]; ok {
+			errs = append(errs, field.Duplicate(path.Index(i), entry))
+		}
+		seen[prefix] = struct{}{}
+	}
+	return errs.ToAggregate()
+}
+
+// ValidateIPFilterUpdate validates the IP filter of an update like ValidateIPFilter, but only if it changed.
+// Updates that don't touch the IP filter, like metadata updates by crossplane, aren't rejected because of it.
+func ValidateIPFilterUpdate(oldFilter, newFilter []string) error {
+	if slices.Equal(oldFilter, newFilter) {
+		return nil
+	}
+	return ValidateIPFilter(newFilter)
+}
+
 func ValidateUpdateVersion(oldObs, oldDes, newDes string) error {
 	oldObserved, err := version.NewVersion(oldObs)
 	if err != nil {
@@ -55,6 +121,29 @@ func ValidateUpdateVersion(oldObs, oldDes, newDes string) error {
 	return nil
 }
 
+// ValidateMajorUpgrade validates that the new desired version doesn't go back to an older major version
+// than the old desired or observed version.
+// Empty old versions are ignored.
+func ValidateMajorUpgrade(oldObs, oldDes, newDes string) error {
+	newDesired, err := version.NewVersion(newDes)
+	if err != nil {
+		return fmt.Errorf("set new desired version '%s' failed: %w", newDes, err)
+	}
+	for _, old := range []string{oldObs, oldDes} {
+		if old == "" {
+			continue
+		}
+		oldVersion, err := version.NewVersion(old)
+		if err != nil {
+			return fmt.Errorf("set old version '%s' failed: %w", old, err)
+		}
+		if newDesired.Segments()[0] < oldVersion.Segments()[0] {
+			return fmt.Errorf("major version downgrade is not supported: %s (old), %s (changed)", old, newDes)
+		}
+	}
+	return nil
+}
+
 func ValidateVersions(wanted string, admittedVersions []string) error {
 	if wanted == "" {
 		return fmt.Errorf("version must be provided")
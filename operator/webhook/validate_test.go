@@ -22,12 +22,14 @@ func TestValidateRawExtension(t *testing.T) {
 		"String":            {givenSpec: `{"string":"value"}`, expectedError: ""},
 		"EmptyString":       {givenSpec: `{"string":""}`, expectedError: ""},
 		"Boolean":           {givenSpec: `{"bool":true}`, expectedError: ""},
-		"EmptyNestedObject": {givenSpec: `{"object":{}}`, expectedError: `validate: value of key "object" is not a supported type (only strings, boolean and numbers): map[]`},
-		"NestedObject":      {givenSpec: `{"object":{"nested":"value"}}`, expectedError: `validate: value of key "object" is not a supported type (only strings, boolean and numbers): map[nested:value]`},
-		"EmptySlice":        {givenSpec: `{"slice":[]}`, expectedError: `validate: value of key "slice" is not a supported type (only strings, boolean and numbers): []`},
-		"NestedSlice":       {givenSpec: `{"slice":["value"]}`, expectedError: `validate: value of key "slice" is not a supported type (only strings, boolean and numbers): [value]`},
+		"EmptyNestedObject": {givenSpec: `{"object":{}}`, expectedError: ""},
+		"NestedObject":      {givenSpec: `{"object":{"nested":"value","number":1,"deeper":{"bool":false}}}`, expectedError: ""},
+		"EmptySlice":        {givenSpec: `{"slice":[]}`, expectedError: ""},
+		"NestedSlice":       {givenSpec: `{"slice":["value",1,{"nested":["value"]}]}`, expectedError: ""},
 		"Slice":             {givenSpec: `[]`, expectedError: `mapper.ToMap({"[]" <nil>}): json: cannot unmarshal array into Go value of type map[string]interface {}`},
-		"NestedNull":        {givenSpec: `{"null":null}`, expectedError: `validate: value of key "null" is not a supported type (only strings, boolean and numbers): <nil>`},
+		"NestedNull":        {givenSpec: `{"null":null}`, expectedError: `validate: value of key "null" is not a supported type (only strings, boolean, numbers, objects and arrays): <nil>`},
+		"NullInObject":      {givenSpec: `{"object":{"nested":{"null":null}}}`, expectedError: `validate: value of key "object"."nested"."null" is not a supported type (only strings, boolean, numbers, objects and arrays): <nil>`},
+		"NullInSlice":       {givenSpec: `{"slice":["value",null]}`, expectedError: `validate: value of key "slice"[1] is not a supported type (only strings, boolean, numbers, objects and arrays): <nil>`},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -87,6 +89,57 @@ func TestValidateUpdateVersion(t *testing.T) {
 	}
 }
 
+func TestValidateMajorUpgrade(t *testing.T) {
+	tests := map[string]struct {
+		oldObservedVersion string
+		oldSpecVersion     string
+		newDesiredVersion  string
+		expectedError      string
+	}{
+		"NoChange": {
+			oldSpecVersion:     "14",
+			newDesiredVersion:  "14",
+			oldObservedVersion: "14.5",
+		},
+		"Upgrade": {
+			oldSpecVersion:     "14",
+			newDesiredVersion:  "15",
+			oldObservedVersion: "14.5",
+		},
+		"Upgrade_SkippingVersions": {
+			oldSpecVersion:     "14",
+			newDesiredVersion:  "17",
+			oldObservedVersion: "14.5",
+		},
+		"Upgrade_NotObservedYet": {
+			oldSpecVersion:    "14",
+			newDesiredVersion: "15",
+		},
+		"Downgrade": {
+			oldSpecVersion:     "15",
+			newDesiredVersion:  "14",
+			oldObservedVersion: "14.5",
+			expectedError:      "major version downgrade is not supported: 15 (old), 14 (changed)",
+		},
+		"Downgrade_BelowObserved": {
+			oldSpecVersion:     "14",
+			newDesiredVersion:  "14",
+			oldObservedVersion: "15.1",
+			expectedError:      "major version downgrade is not supported: 15.1 (old), 14 (changed)",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateMajorUpgrade(tc.oldObservedVersion, tc.oldSpecVersion, tc.newDesiredVersion)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateVersions(t *testing.T) {
 	tests := map[string]struct {
 		wanted           string
@@ -125,3 +178,80 @@ func TestValidateVersions(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateIPFilter(t *testing.T) {
+	tests := map[string]struct {
+		filter        []string
+		expectedError string
+	}{
+		"valid filter": {
+			filter: []string{"0.0.0.0/0", "10.0.0.1", "2001:db8::/32"},
+		},
+		"empty filter": {
+			filter:        []string{},
+			expectedError: "spec.forProvider.ipFilter: Required value: IP filter cannot be empty",
+		},
+		"invalid entry": {
+			filter:        []string{"10.0.0.0/8", "10.0.0.300"},
+			expectedError: `spec.forProvider.ipFilter[1]: Invalid value: "10.0.0.300": must be an IP address or CIDR range`,
+		},
+		"invalid prefix length": {
+			filter:        []string{"10.0.0.0/33"},
+			expectedError: `spec.forProvider.ipFilter[0]: Invalid value: "10.0.0.0/33": must be an IP address or CIDR range`,
+		},
+		"equivalent entries": {
+			filter:        []string{"10.0.0.1", "10.0.0.1/32"},
+			expectedError: `spec.forProvider.ipFilter[1]: Duplicate value: "10.0.0.1/32"`,
+		},
+		"multiple errors": {
+			filter:        []string{"foo", "bar"},
+			expectedError: `[spec.forProvider.ipFilter[0]: Invalid value: "foo": must be an IP address or CIDR range, spec.forProvider.ipFilter[1]: Invalid value: "bar": must be an IP address or CIDR range]`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateIPFilter(tc.filter)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateIPFilterUpdate(t *testing.T) {
+	tests := map[string]struct {
+		oldFilter     []string
+		newFilter     []string
+		expectedError string
+	}{
+		"unchanged invalid filter": {
+			oldFilter: []string{"10.0.0.1", "10.0.0.1/32"},
+			newFilter: []string{"10.0.0.1", "10.0.0.1/32"},
+		},
+		"changed valid filter": {
+			oldFilter: []string{"10.0.0.1", "10.0.0.1/32"},
+			newFilter: []string{"10.0.0.1"},
+		},
+		"changed invalid filter": {
+			oldFilter:     []string{"10.0.0.1"},
+			newFilter:     []string{"10.0.0.300"},
+			expectedError: `spec.forProvider.ipFilter[0]: Invalid value: "10.0.0.300": must be an IP address or CIDR range`,
+		},
+		"removed filter": {
+			oldFilter:     []string{"10.0.0.1"},
+			expectedError: "spec.forProvider.ipFilter: Required value: IP filter cannot be empty",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateIPFilterUpdate(tc.oldFilter, tc.newFilter)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}